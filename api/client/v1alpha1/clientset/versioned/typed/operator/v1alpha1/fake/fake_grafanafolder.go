@@ -100,6 +100,18 @@ func (c *FakeGrafanaFolders) Update(ctx context.Context, grafanaFolder *v1alpha1
 	return obj.(*v1alpha1.GrafanaFolder), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeGrafanaFolders) UpdateStatus(ctx context.Context, grafanaFolder *v1alpha1.GrafanaFolder, opts v1.UpdateOptions) (*v1alpha1.GrafanaFolder, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(grafanafoldersResource, "status", c.ns, grafanaFolder), &v1alpha1.GrafanaFolder{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.GrafanaFolder), err
+}
+
 // Delete takes name of the grafanaFolder and deletes it. Returns an error if one occurs.
 func (c *FakeGrafanaFolders) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	_, err := c.Fake.
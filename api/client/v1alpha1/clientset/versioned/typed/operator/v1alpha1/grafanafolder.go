@@ -39,6 +39,7 @@ type GrafanaFoldersGetter interface {
 type GrafanaFolderInterface interface {
 	Create(ctx context.Context, grafanaFolder *v1alpha1.GrafanaFolder, opts v1.CreateOptions) (*v1alpha1.GrafanaFolder, error)
 	Update(ctx context.Context, grafanaFolder *v1alpha1.GrafanaFolder, opts v1.UpdateOptions) (*v1alpha1.GrafanaFolder, error)
+	UpdateStatus(ctx context.Context, grafanaFolder *v1alpha1.GrafanaFolder, opts v1.UpdateOptions) (*v1alpha1.GrafanaFolder, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.GrafanaFolder, error)
@@ -134,6 +135,22 @@ func (c *grafanaFolders) Update(ctx context.Context, grafanaFolder *v1alpha1.Gra
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *grafanaFolders) UpdateStatus(ctx context.Context, grafanaFolder *v1alpha1.GrafanaFolder, opts v1.UpdateOptions) (result *v1alpha1.GrafanaFolder, err error) {
+	result = &v1alpha1.GrafanaFolder{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("grafanafolders").
+		Name(grafanaFolder.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(grafanaFolder).
+		Do(ctx).
+		Into(result)
+	return
+}
+
 // Delete takes name of the grafanaFolder and deletes it. Returns an error if one occurs.
 func (c *grafanaFolders) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -18,7 +18,7 @@ limitations under the License.
 package externalversions
 
 import (
-	"fmt"
+	fmt "fmt"
 
 	v1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
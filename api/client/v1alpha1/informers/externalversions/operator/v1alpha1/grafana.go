@@ -18,15 +18,16 @@ limitations under the License.
 package v1alpha1
 
 import (
-	"context"
+	context "context"
 	time "time"
 
 	versioned "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned"
 	internalinterfaces "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions/internalinterfaces"
-	v1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/listers/operator/v1alpha1"
-	operatorv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	operatorv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/listers/operator/v1alpha1"
+	apioperatorv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	watch "k8s.io/apimachinery/pkg/watch"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -35,7 +36,7 @@ import (
 // Grafanas.
 type GrafanaInformer interface {
 	Informer() cache.SharedIndexInformer
-	Lister() v1alpha1.GrafanaLister
+	Lister() operatorv1alpha1.GrafanaLister
 }
 
 type grafanaInformer struct {
@@ -48,42 +49,67 @@ type grafanaInformer struct {
 // Always prefer using an informer factory to get a shared informer instead of getting an independent
 // one. This reduces memory footprint and number of connections to the server.
 func NewGrafanaInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
-	return NewFilteredGrafanaInformer(client, namespace, resyncPeriod, indexers, nil)
+	return NewGrafanaInformerWithOptions(client, namespace, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: indexers})
 }
 
 // NewFilteredGrafanaInformer constructs a new informer for Grafana type.
 // Always prefer using an informer factory to get a shared informer instead of getting an independent
 // one. This reduces memory footprint and number of connections to the server.
 func NewFilteredGrafanaInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
-	return cache.NewSharedIndexInformer(
-		&cache.ListWatch{
-			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+	return NewGrafanaInformerWithOptions(client, namespace, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: indexers, TweakListOptions: tweakListOptions})
+}
+
+// NewGrafanaInformerWithOptions constructs a new informer for Grafana type with additional options.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewGrafanaInformerWithOptions(client versioned.Interface, namespace string, options internalinterfaces.InformerOptions) cache.SharedIndexInformer {
+	gvr := schema.GroupVersionResource{Group: "integreatly.org", Version: "v1alpha1", Resource: "grafanas"}
+	identifier := options.InformerName.WithResource(gvr)
+	tweakListOptions := options.TweakListOptions
+	return cache.NewSharedIndexInformerWithOptions(
+		cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+			ListFunc: func(opts v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&opts)
+				}
+				return client.IntegreatlyV1alpha1().Grafanas(namespace).List(context.Background(), opts)
+			},
+			WatchFunc: func(opts v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&opts)
+				}
+				return client.IntegreatlyV1alpha1().Grafanas(namespace).Watch(context.Background(), opts)
+			},
+			ListWithContextFunc: func(ctx context.Context, opts v1.ListOptions) (runtime.Object, error) {
 				if tweakListOptions != nil {
-					tweakListOptions(&options)
+					tweakListOptions(&opts)
 				}
-				return client.IntegreatlyV1alpha1().Grafanas(namespace).List(context.TODO(), options)
+				return client.IntegreatlyV1alpha1().Grafanas(namespace).List(ctx, opts)
 			},
-			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+			WatchFuncWithContext: func(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 				if tweakListOptions != nil {
-					tweakListOptions(&options)
+					tweakListOptions(&opts)
 				}
-				return client.IntegreatlyV1alpha1().Grafanas(namespace).Watch(context.TODO(), options)
+				return client.IntegreatlyV1alpha1().Grafanas(namespace).Watch(ctx, opts)
 			},
+		}, client),
+		&apioperatorv1alpha1.Grafana{},
+		cache.SharedIndexInformerOptions{
+			ResyncPeriod: options.ResyncPeriod,
+			Indexers:     options.Indexers,
+			Identifier:   identifier,
 		},
-		&operatorv1alpha1.Grafana{},
-		resyncPeriod,
-		indexers,
 	)
 }
 
 func (f *grafanaInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
-	return NewFilteredGrafanaInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+	return NewGrafanaInformerWithOptions(client, f.namespace, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, InformerName: f.factory.InformerName(), TweakListOptions: f.tweakListOptions})
 }
 
 func (f *grafanaInformer) Informer() cache.SharedIndexInformer {
-	return f.factory.InformerFor(&operatorv1alpha1.Grafana{}, f.defaultInformer)
+	return f.factory.InformerFor(&apioperatorv1alpha1.Grafana{}, f.defaultInformer)
 }
 
-func (f *grafanaInformer) Lister() v1alpha1.GrafanaLister {
-	return v1alpha1.NewGrafanaLister(f.Informer().GetIndexer())
+func (f *grafanaInformer) Lister() operatorv1alpha1.GrafanaLister {
+	return operatorv1alpha1.NewGrafanaLister(f.Informer().GetIndexer())
 }
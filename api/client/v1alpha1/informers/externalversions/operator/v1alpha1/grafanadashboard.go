@@ -18,15 +18,16 @@ limitations under the License.
 package v1alpha1
 
 import (
-	"context"
+	context "context"
 	time "time"
 
 	versioned "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned"
 	internalinterfaces "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions/internalinterfaces"
-	v1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/listers/operator/v1alpha1"
-	operatorv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	operatorv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/listers/operator/v1alpha1"
+	apioperatorv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	watch "k8s.io/apimachinery/pkg/watch"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -35,7 +36,7 @@ import (
 // GrafanaDashboards.
 type GrafanaDashboardInformer interface {
 	Informer() cache.SharedIndexInformer
-	Lister() v1alpha1.GrafanaDashboardLister
+	Lister() operatorv1alpha1.GrafanaDashboardLister
 }
 
 type grafanaDashboardInformer struct {
@@ -48,42 +49,67 @@ type grafanaDashboardInformer struct {
 // Always prefer using an informer factory to get a shared informer instead of getting an independent
 // one. This reduces memory footprint and number of connections to the server.
 func NewGrafanaDashboardInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
-	return NewFilteredGrafanaDashboardInformer(client, namespace, resyncPeriod, indexers, nil)
+	return NewGrafanaDashboardInformerWithOptions(client, namespace, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: indexers})
 }
 
 // NewFilteredGrafanaDashboardInformer constructs a new informer for GrafanaDashboard type.
 // Always prefer using an informer factory to get a shared informer instead of getting an independent
 // one. This reduces memory footprint and number of connections to the server.
 func NewFilteredGrafanaDashboardInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
-	return cache.NewSharedIndexInformer(
-		&cache.ListWatch{
-			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+	return NewGrafanaDashboardInformerWithOptions(client, namespace, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: indexers, TweakListOptions: tweakListOptions})
+}
+
+// NewGrafanaDashboardInformerWithOptions constructs a new informer for GrafanaDashboard type with additional options.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewGrafanaDashboardInformerWithOptions(client versioned.Interface, namespace string, options internalinterfaces.InformerOptions) cache.SharedIndexInformer {
+	gvr := schema.GroupVersionResource{Group: "integreatly.org", Version: "v1alpha1", Resource: "grafanadashboards"}
+	identifier := options.InformerName.WithResource(gvr)
+	tweakListOptions := options.TweakListOptions
+	return cache.NewSharedIndexInformerWithOptions(
+		cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+			ListFunc: func(opts v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&opts)
+				}
+				return client.IntegreatlyV1alpha1().GrafanaDashboards(namespace).List(context.Background(), opts)
+			},
+			WatchFunc: func(opts v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&opts)
+				}
+				return client.IntegreatlyV1alpha1().GrafanaDashboards(namespace).Watch(context.Background(), opts)
+			},
+			ListWithContextFunc: func(ctx context.Context, opts v1.ListOptions) (runtime.Object, error) {
 				if tweakListOptions != nil {
-					tweakListOptions(&options)
+					tweakListOptions(&opts)
 				}
-				return client.IntegreatlyV1alpha1().GrafanaDashboards(namespace).List(context.TODO(), options)
+				return client.IntegreatlyV1alpha1().GrafanaDashboards(namespace).List(ctx, opts)
 			},
-			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+			WatchFuncWithContext: func(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 				if tweakListOptions != nil {
-					tweakListOptions(&options)
+					tweakListOptions(&opts)
 				}
-				return client.IntegreatlyV1alpha1().GrafanaDashboards(namespace).Watch(context.TODO(), options)
+				return client.IntegreatlyV1alpha1().GrafanaDashboards(namespace).Watch(ctx, opts)
 			},
+		}, client),
+		&apioperatorv1alpha1.GrafanaDashboard{},
+		cache.SharedIndexInformerOptions{
+			ResyncPeriod: options.ResyncPeriod,
+			Indexers:     options.Indexers,
+			Identifier:   identifier,
 		},
-		&operatorv1alpha1.GrafanaDashboard{},
-		resyncPeriod,
-		indexers,
 	)
 }
 
 func (f *grafanaDashboardInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
-	return NewFilteredGrafanaDashboardInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+	return NewGrafanaDashboardInformerWithOptions(client, f.namespace, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, InformerName: f.factory.InformerName(), TweakListOptions: f.tweakListOptions})
 }
 
 func (f *grafanaDashboardInformer) Informer() cache.SharedIndexInformer {
-	return f.factory.InformerFor(&operatorv1alpha1.GrafanaDashboard{}, f.defaultInformer)
+	return f.factory.InformerFor(&apioperatorv1alpha1.GrafanaDashboard{}, f.defaultInformer)
 }
 
-func (f *grafanaDashboardInformer) Lister() v1alpha1.GrafanaDashboardLister {
-	return v1alpha1.NewGrafanaDashboardLister(f.Informer().GetIndexer())
+func (f *grafanaDashboardInformer) Lister() operatorv1alpha1.GrafanaDashboardLister {
+	return operatorv1alpha1.NewGrafanaDashboardLister(f.Informer().GetIndexer())
 }
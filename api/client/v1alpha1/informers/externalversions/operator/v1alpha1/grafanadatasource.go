@@ -18,15 +18,16 @@ limitations under the License.
 package v1alpha1
 
 import (
-	"context"
+	context "context"
 	time "time"
 
 	versioned "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned"
 	internalinterfaces "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions/internalinterfaces"
-	v1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/listers/operator/v1alpha1"
-	operatorv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	operatorv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/listers/operator/v1alpha1"
+	apioperatorv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	watch "k8s.io/apimachinery/pkg/watch"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -35,7 +36,7 @@ import (
 // GrafanaDataSources.
 type GrafanaDataSourceInformer interface {
 	Informer() cache.SharedIndexInformer
-	Lister() v1alpha1.GrafanaDataSourceLister
+	Lister() operatorv1alpha1.GrafanaDataSourceLister
 }
 
 type grafanaDataSourceInformer struct {
@@ -48,42 +49,67 @@ type grafanaDataSourceInformer struct {
 // Always prefer using an informer factory to get a shared informer instead of getting an independent
 // one. This reduces memory footprint and number of connections to the server.
 func NewGrafanaDataSourceInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
-	return NewFilteredGrafanaDataSourceInformer(client, namespace, resyncPeriod, indexers, nil)
+	return NewGrafanaDataSourceInformerWithOptions(client, namespace, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: indexers})
 }
 
 // NewFilteredGrafanaDataSourceInformer constructs a new informer for GrafanaDataSource type.
 // Always prefer using an informer factory to get a shared informer instead of getting an independent
 // one. This reduces memory footprint and number of connections to the server.
 func NewFilteredGrafanaDataSourceInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
-	return cache.NewSharedIndexInformer(
-		&cache.ListWatch{
-			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+	return NewGrafanaDataSourceInformerWithOptions(client, namespace, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: indexers, TweakListOptions: tweakListOptions})
+}
+
+// NewGrafanaDataSourceInformerWithOptions constructs a new informer for GrafanaDataSource type with additional options.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewGrafanaDataSourceInformerWithOptions(client versioned.Interface, namespace string, options internalinterfaces.InformerOptions) cache.SharedIndexInformer {
+	gvr := schema.GroupVersionResource{Group: "integreatly.org", Version: "v1alpha1", Resource: "grafanadatasources"}
+	identifier := options.InformerName.WithResource(gvr)
+	tweakListOptions := options.TweakListOptions
+	return cache.NewSharedIndexInformerWithOptions(
+		cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+			ListFunc: func(opts v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&opts)
+				}
+				return client.IntegreatlyV1alpha1().GrafanaDataSources(namespace).List(context.Background(), opts)
+			},
+			WatchFunc: func(opts v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&opts)
+				}
+				return client.IntegreatlyV1alpha1().GrafanaDataSources(namespace).Watch(context.Background(), opts)
+			},
+			ListWithContextFunc: func(ctx context.Context, opts v1.ListOptions) (runtime.Object, error) {
 				if tweakListOptions != nil {
-					tweakListOptions(&options)
+					tweakListOptions(&opts)
 				}
-				return client.IntegreatlyV1alpha1().GrafanaDataSources(namespace).List(context.TODO(), options)
+				return client.IntegreatlyV1alpha1().GrafanaDataSources(namespace).List(ctx, opts)
 			},
-			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+			WatchFuncWithContext: func(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 				if tweakListOptions != nil {
-					tweakListOptions(&options)
+					tweakListOptions(&opts)
 				}
-				return client.IntegreatlyV1alpha1().GrafanaDataSources(namespace).Watch(context.TODO(), options)
+				return client.IntegreatlyV1alpha1().GrafanaDataSources(namespace).Watch(ctx, opts)
 			},
+		}, client),
+		&apioperatorv1alpha1.GrafanaDataSource{},
+		cache.SharedIndexInformerOptions{
+			ResyncPeriod: options.ResyncPeriod,
+			Indexers:     options.Indexers,
+			Identifier:   identifier,
 		},
-		&operatorv1alpha1.GrafanaDataSource{},
-		resyncPeriod,
-		indexers,
 	)
 }
 
 func (f *grafanaDataSourceInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
-	return NewFilteredGrafanaDataSourceInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+	return NewGrafanaDataSourceInformerWithOptions(client, f.namespace, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, InformerName: f.factory.InformerName(), TweakListOptions: f.tweakListOptions})
 }
 
 func (f *grafanaDataSourceInformer) Informer() cache.SharedIndexInformer {
-	return f.factory.InformerFor(&operatorv1alpha1.GrafanaDataSource{}, f.defaultInformer)
+	return f.factory.InformerFor(&apioperatorv1alpha1.GrafanaDataSource{}, f.defaultInformer)
 }
 
-func (f *grafanaDataSourceInformer) Lister() v1alpha1.GrafanaDataSourceLister {
-	return v1alpha1.NewGrafanaDataSourceLister(f.Informer().GetIndexer())
+func (f *grafanaDataSourceInformer) Lister() operatorv1alpha1.GrafanaDataSourceLister {
+	return operatorv1alpha1.NewGrafanaDataSourceLister(f.Informer().GetIndexer())
 }
@@ -18,15 +18,16 @@ limitations under the License.
 package v1alpha1
 
 import (
-	"context"
+	context "context"
 	time "time"
 
 	versioned "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned"
 	internalinterfaces "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions/internalinterfaces"
-	v1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/listers/operator/v1alpha1"
-	operatorv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	operatorv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/listers/operator/v1alpha1"
+	apioperatorv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	watch "k8s.io/apimachinery/pkg/watch"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -35,7 +36,7 @@ import (
 // GrafanaNotificationChannels.
 type GrafanaNotificationChannelInformer interface {
 	Informer() cache.SharedIndexInformer
-	Lister() v1alpha1.GrafanaNotificationChannelLister
+	Lister() operatorv1alpha1.GrafanaNotificationChannelLister
 }
 
 type grafanaNotificationChannelInformer struct {
@@ -48,42 +49,67 @@ type grafanaNotificationChannelInformer struct {
 // Always prefer using an informer factory to get a shared informer instead of getting an independent
 // one. This reduces memory footprint and number of connections to the server.
 func NewGrafanaNotificationChannelInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
-	return NewFilteredGrafanaNotificationChannelInformer(client, namespace, resyncPeriod, indexers, nil)
+	return NewGrafanaNotificationChannelInformerWithOptions(client, namespace, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: indexers})
 }
 
 // NewFilteredGrafanaNotificationChannelInformer constructs a new informer for GrafanaNotificationChannel type.
 // Always prefer using an informer factory to get a shared informer instead of getting an independent
 // one. This reduces memory footprint and number of connections to the server.
 func NewFilteredGrafanaNotificationChannelInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
-	return cache.NewSharedIndexInformer(
-		&cache.ListWatch{
-			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+	return NewGrafanaNotificationChannelInformerWithOptions(client, namespace, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: indexers, TweakListOptions: tweakListOptions})
+}
+
+// NewGrafanaNotificationChannelInformerWithOptions constructs a new informer for GrafanaNotificationChannel type with additional options.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewGrafanaNotificationChannelInformerWithOptions(client versioned.Interface, namespace string, options internalinterfaces.InformerOptions) cache.SharedIndexInformer {
+	gvr := schema.GroupVersionResource{Group: "integreatly.org", Version: "v1alpha1", Resource: "grafananotificationchannels"}
+	identifier := options.InformerName.WithResource(gvr)
+	tweakListOptions := options.TweakListOptions
+	return cache.NewSharedIndexInformerWithOptions(
+		cache.ToListWatcherWithWatchListSemantics(&cache.ListWatch{
+			ListFunc: func(opts v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&opts)
+				}
+				return client.IntegreatlyV1alpha1().GrafanaNotificationChannels(namespace).List(context.Background(), opts)
+			},
+			WatchFunc: func(opts v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&opts)
+				}
+				return client.IntegreatlyV1alpha1().GrafanaNotificationChannels(namespace).Watch(context.Background(), opts)
+			},
+			ListWithContextFunc: func(ctx context.Context, opts v1.ListOptions) (runtime.Object, error) {
 				if tweakListOptions != nil {
-					tweakListOptions(&options)
+					tweakListOptions(&opts)
 				}
-				return client.IntegreatlyV1alpha1().GrafanaNotificationChannels(namespace).List(context.TODO(), options)
+				return client.IntegreatlyV1alpha1().GrafanaNotificationChannels(namespace).List(ctx, opts)
 			},
-			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+			WatchFuncWithContext: func(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 				if tweakListOptions != nil {
-					tweakListOptions(&options)
+					tweakListOptions(&opts)
 				}
-				return client.IntegreatlyV1alpha1().GrafanaNotificationChannels(namespace).Watch(context.TODO(), options)
+				return client.IntegreatlyV1alpha1().GrafanaNotificationChannels(namespace).Watch(ctx, opts)
 			},
+		}, client),
+		&apioperatorv1alpha1.GrafanaNotificationChannel{},
+		cache.SharedIndexInformerOptions{
+			ResyncPeriod: options.ResyncPeriod,
+			Indexers:     options.Indexers,
+			Identifier:   identifier,
 		},
-		&operatorv1alpha1.GrafanaNotificationChannel{},
-		resyncPeriod,
-		indexers,
 	)
 }
 
 func (f *grafanaNotificationChannelInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
-	return NewFilteredGrafanaNotificationChannelInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+	return NewGrafanaNotificationChannelInformerWithOptions(client, f.namespace, internalinterfaces.InformerOptions{ResyncPeriod: resyncPeriod, Indexers: cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, InformerName: f.factory.InformerName(), TweakListOptions: f.tweakListOptions})
 }
 
 func (f *grafanaNotificationChannelInformer) Informer() cache.SharedIndexInformer {
-	return f.factory.InformerFor(&operatorv1alpha1.GrafanaNotificationChannel{}, f.defaultInformer)
+	return f.factory.InformerFor(&apioperatorv1alpha1.GrafanaNotificationChannel{}, f.defaultInformer)
 }
 
-func (f *grafanaNotificationChannelInformer) Lister() v1alpha1.GrafanaNotificationChannelLister {
-	return v1alpha1.NewGrafanaNotificationChannelLister(f.Informer().GetIndexer())
+func (f *grafanaNotificationChannelInformer) Lister() operatorv1alpha1.GrafanaNotificationChannelLister {
+	return operatorv1alpha1.NewGrafanaNotificationChannelLister(f.Informer().GetIndexer())
 }
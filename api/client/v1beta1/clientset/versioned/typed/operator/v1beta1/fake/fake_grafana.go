@@ -13,128 +13,39 @@ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 See the License for the specific language governing permissions and
 limitations under the License.
 */
+
 // Code generated by client-gen. DO NOT EDIT.
 
 package fake
 
 import (
-	"context"
-
+	operatorv1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/applyconfiguration/operator/v1beta1"
+	typedoperatorv1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/clientset/versioned/typed/operator/v1beta1"
 	v1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	labels "k8s.io/apimachinery/pkg/labels"
-	types "k8s.io/apimachinery/pkg/types"
-	watch "k8s.io/apimachinery/pkg/watch"
-	testing "k8s.io/client-go/testing"
+	gentype "k8s.io/client-go/gentype"
 )
 
-// FakeGrafanas implements GrafanaInterface
-type FakeGrafanas struct {
+// fakeGrafanas implements GrafanaInterface
+type fakeGrafanas struct {
+	*gentype.FakeClientWithListAndApply[*v1beta1.Grafana, *v1beta1.GrafanaList, *operatorv1beta1.GrafanaApplyConfiguration]
 	Fake *FakeGrafanaIntegreatlyV1beta1
-	ns   string
-}
-
-var grafanasResource = v1beta1.SchemeGroupVersion.WithResource("grafanas")
-
-var grafanasKind = v1beta1.SchemeGroupVersion.WithKind("Grafana")
-
-// Get takes name of the grafana, and returns the corresponding grafana object, and an error if there is any.
-func (c *FakeGrafanas) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.Grafana, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewGetAction(grafanasResource, c.ns, name), &v1beta1.Grafana{})
-
-	if obj == nil {
-		return nil, err
-	}
-	return obj.(*v1beta1.Grafana), err
 }
 
-// List takes label and field selectors, and returns the list of Grafanas that match those selectors.
-func (c *FakeGrafanas) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.GrafanaList, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewListAction(grafanasResource, grafanasKind, c.ns, opts), &v1beta1.GrafanaList{})
-
-	if obj == nil {
-		return nil, err
-	}
-
-	label, _, _ := testing.ExtractFromListOptions(opts)
-	if label == nil {
-		label = labels.Everything()
-	}
-	list := &v1beta1.GrafanaList{ListMeta: obj.(*v1beta1.GrafanaList).ListMeta}
-	for _, item := range obj.(*v1beta1.GrafanaList).Items {
-		if label.Matches(labels.Set(item.Labels)) {
-			list.Items = append(list.Items, item)
-		}
-	}
-	return list, err
-}
-
-// Watch returns a watch.Interface that watches the requested grafanas.
-func (c *FakeGrafanas) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
-	return c.Fake.
-		InvokesWatch(testing.NewWatchAction(grafanasResource, c.ns, opts))
-
-}
-
-// Create takes the representation of a grafana and creates it.  Returns the server's representation of the grafana, and an error, if there is any.
-func (c *FakeGrafanas) Create(ctx context.Context, grafana *v1beta1.Grafana, opts v1.CreateOptions) (result *v1beta1.Grafana, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(grafanasResource, c.ns, grafana), &v1beta1.Grafana{})
-
-	if obj == nil {
-		return nil, err
-	}
-	return obj.(*v1beta1.Grafana), err
-}
-
-// Update takes the representation of a grafana and updates it. Returns the server's representation of the grafana, and an error, if there is any.
-func (c *FakeGrafanas) Update(ctx context.Context, grafana *v1beta1.Grafana, opts v1.UpdateOptions) (result *v1beta1.Grafana, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(grafanasResource, c.ns, grafana), &v1beta1.Grafana{})
-
-	if obj == nil {
-		return nil, err
-	}
-	return obj.(*v1beta1.Grafana), err
-}
-
-// UpdateStatus was generated because the type contains a Status member.
-// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeGrafanas) UpdateStatus(ctx context.Context, grafana *v1beta1.Grafana, opts v1.UpdateOptions) (*v1beta1.Grafana, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(grafanasResource, "status", c.ns, grafana), &v1beta1.Grafana{})
-
-	if obj == nil {
-		return nil, err
-	}
-	return obj.(*v1beta1.Grafana), err
-}
-
-// Delete takes name of the grafana and deletes it. Returns an error if one occurs.
-func (c *FakeGrafanas) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteActionWithOptions(grafanasResource, c.ns, name, opts), &v1beta1.Grafana{})
-
-	return err
-}
-
-// DeleteCollection deletes a collection of objects.
-func (c *FakeGrafanas) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
-	action := testing.NewDeleteCollectionAction(grafanasResource, c.ns, listOpts)
-
-	_, err := c.Fake.Invokes(action, &v1beta1.GrafanaList{})
-	return err
-}
-
-// Patch applies the patch and returns the patched grafana.
-func (c *FakeGrafanas) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Grafana, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(grafanasResource, c.ns, name, pt, data, subresources...), &v1beta1.Grafana{})
-
-	if obj == nil {
-		return nil, err
+func newFakeGrafanas(fake *FakeGrafanaIntegreatlyV1beta1, namespace string) typedoperatorv1beta1.GrafanaInterface {
+	return &fakeGrafanas{
+		gentype.NewFakeClientWithListAndApply[*v1beta1.Grafana, *v1beta1.GrafanaList, *operatorv1beta1.GrafanaApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta1.SchemeGroupVersion.WithResource("grafanas"),
+			v1beta1.SchemeGroupVersion.WithKind("Grafana"),
+			func() *v1beta1.Grafana { return &v1beta1.Grafana{} },
+			func() *v1beta1.GrafanaList { return &v1beta1.GrafanaList{} },
+			func(dst, src *v1beta1.GrafanaList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.GrafanaList) []*v1beta1.Grafana { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1beta1.GrafanaList, items []*v1beta1.Grafana) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
 	}
-	return obj.(*v1beta1.Grafana), err
 }
@@ -13,128 +13,41 @@ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 See the License for the specific language governing permissions and
 limitations under the License.
 */
+
 // Code generated by client-gen. DO NOT EDIT.
 
 package fake
 
 import (
-	"context"
-
+	operatorv1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/applyconfiguration/operator/v1beta1"
+	typedoperatorv1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/clientset/versioned/typed/operator/v1beta1"
 	v1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	labels "k8s.io/apimachinery/pkg/labels"
-	types "k8s.io/apimachinery/pkg/types"
-	watch "k8s.io/apimachinery/pkg/watch"
-	testing "k8s.io/client-go/testing"
+	gentype "k8s.io/client-go/gentype"
 )
 
-// FakeGrafanaDashboards implements GrafanaDashboardInterface
-type FakeGrafanaDashboards struct {
+// fakeGrafanaDashboards implements GrafanaDashboardInterface
+type fakeGrafanaDashboards struct {
+	*gentype.FakeClientWithListAndApply[*v1beta1.GrafanaDashboard, *v1beta1.GrafanaDashboardList, *operatorv1beta1.GrafanaDashboardApplyConfiguration]
 	Fake *FakeGrafanaIntegreatlyV1beta1
-	ns   string
-}
-
-var grafanadashboardsResource = v1beta1.SchemeGroupVersion.WithResource("grafanadashboards")
-
-var grafanadashboardsKind = v1beta1.SchemeGroupVersion.WithKind("GrafanaDashboard")
-
-// Get takes name of the grafanaDashboard, and returns the corresponding grafanaDashboard object, and an error if there is any.
-func (c *FakeGrafanaDashboards) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.GrafanaDashboard, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewGetAction(grafanadashboardsResource, c.ns, name), &v1beta1.GrafanaDashboard{})
-
-	if obj == nil {
-		return nil, err
-	}
-	return obj.(*v1beta1.GrafanaDashboard), err
 }
 
-// List takes label and field selectors, and returns the list of GrafanaDashboards that match those selectors.
-func (c *FakeGrafanaDashboards) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.GrafanaDashboardList, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewListAction(grafanadashboardsResource, grafanadashboardsKind, c.ns, opts), &v1beta1.GrafanaDashboardList{})
-
-	if obj == nil {
-		return nil, err
-	}
-
-	label, _, _ := testing.ExtractFromListOptions(opts)
-	if label == nil {
-		label = labels.Everything()
-	}
-	list := &v1beta1.GrafanaDashboardList{ListMeta: obj.(*v1beta1.GrafanaDashboardList).ListMeta}
-	for _, item := range obj.(*v1beta1.GrafanaDashboardList).Items {
-		if label.Matches(labels.Set(item.Labels)) {
-			list.Items = append(list.Items, item)
-		}
-	}
-	return list, err
-}
-
-// Watch returns a watch.Interface that watches the requested grafanaDashboards.
-func (c *FakeGrafanaDashboards) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
-	return c.Fake.
-		InvokesWatch(testing.NewWatchAction(grafanadashboardsResource, c.ns, opts))
-
-}
-
-// Create takes the representation of a grafanaDashboard and creates it.  Returns the server's representation of the grafanaDashboard, and an error, if there is any.
-func (c *FakeGrafanaDashboards) Create(ctx context.Context, grafanaDashboard *v1beta1.GrafanaDashboard, opts v1.CreateOptions) (result *v1beta1.GrafanaDashboard, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(grafanadashboardsResource, c.ns, grafanaDashboard), &v1beta1.GrafanaDashboard{})
-
-	if obj == nil {
-		return nil, err
-	}
-	return obj.(*v1beta1.GrafanaDashboard), err
-}
-
-// Update takes the representation of a grafanaDashboard and updates it. Returns the server's representation of the grafanaDashboard, and an error, if there is any.
-func (c *FakeGrafanaDashboards) Update(ctx context.Context, grafanaDashboard *v1beta1.GrafanaDashboard, opts v1.UpdateOptions) (result *v1beta1.GrafanaDashboard, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(grafanadashboardsResource, c.ns, grafanaDashboard), &v1beta1.GrafanaDashboard{})
-
-	if obj == nil {
-		return nil, err
-	}
-	return obj.(*v1beta1.GrafanaDashboard), err
-}
-
-// UpdateStatus was generated because the type contains a Status member.
-// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeGrafanaDashboards) UpdateStatus(ctx context.Context, grafanaDashboard *v1beta1.GrafanaDashboard, opts v1.UpdateOptions) (*v1beta1.GrafanaDashboard, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(grafanadashboardsResource, "status", c.ns, grafanaDashboard), &v1beta1.GrafanaDashboard{})
-
-	if obj == nil {
-		return nil, err
-	}
-	return obj.(*v1beta1.GrafanaDashboard), err
-}
-
-// Delete takes name of the grafanaDashboard and deletes it. Returns an error if one occurs.
-func (c *FakeGrafanaDashboards) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteActionWithOptions(grafanadashboardsResource, c.ns, name, opts), &v1beta1.GrafanaDashboard{})
-
-	return err
-}
-
-// DeleteCollection deletes a collection of objects.
-func (c *FakeGrafanaDashboards) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
-	action := testing.NewDeleteCollectionAction(grafanadashboardsResource, c.ns, listOpts)
-
-	_, err := c.Fake.Invokes(action, &v1beta1.GrafanaDashboardList{})
-	return err
-}
-
-// Patch applies the patch and returns the patched grafanaDashboard.
-func (c *FakeGrafanaDashboards) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GrafanaDashboard, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(grafanadashboardsResource, c.ns, name, pt, data, subresources...), &v1beta1.GrafanaDashboard{})
-
-	if obj == nil {
-		return nil, err
+func newFakeGrafanaDashboards(fake *FakeGrafanaIntegreatlyV1beta1, namespace string) typedoperatorv1beta1.GrafanaDashboardInterface {
+	return &fakeGrafanaDashboards{
+		gentype.NewFakeClientWithListAndApply[*v1beta1.GrafanaDashboard, *v1beta1.GrafanaDashboardList, *operatorv1beta1.GrafanaDashboardApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta1.SchemeGroupVersion.WithResource("grafanadashboards"),
+			v1beta1.SchemeGroupVersion.WithKind("GrafanaDashboard"),
+			func() *v1beta1.GrafanaDashboard { return &v1beta1.GrafanaDashboard{} },
+			func() *v1beta1.GrafanaDashboardList { return &v1beta1.GrafanaDashboardList{} },
+			func(dst, src *v1beta1.GrafanaDashboardList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.GrafanaDashboardList) []*v1beta1.GrafanaDashboard {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.GrafanaDashboardList, items []*v1beta1.GrafanaDashboard) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
 	}
-	return obj.(*v1beta1.GrafanaDashboard), err
 }
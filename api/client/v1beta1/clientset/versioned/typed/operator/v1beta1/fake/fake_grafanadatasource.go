@@ -13,128 +13,41 @@ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 See the License for the specific language governing permissions and
 limitations under the License.
 */
+
 // Code generated by client-gen. DO NOT EDIT.
 
 package fake
 
 import (
-	"context"
-
+	operatorv1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/applyconfiguration/operator/v1beta1"
+	typedoperatorv1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/clientset/versioned/typed/operator/v1beta1"
 	v1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	labels "k8s.io/apimachinery/pkg/labels"
-	types "k8s.io/apimachinery/pkg/types"
-	watch "k8s.io/apimachinery/pkg/watch"
-	testing "k8s.io/client-go/testing"
+	gentype "k8s.io/client-go/gentype"
 )
 
-// FakeGrafanaDatasources implements GrafanaDatasourceInterface
-type FakeGrafanaDatasources struct {
+// fakeGrafanaDatasources implements GrafanaDatasourceInterface
+type fakeGrafanaDatasources struct {
+	*gentype.FakeClientWithListAndApply[*v1beta1.GrafanaDatasource, *v1beta1.GrafanaDatasourceList, *operatorv1beta1.GrafanaDatasourceApplyConfiguration]
 	Fake *FakeGrafanaIntegreatlyV1beta1
-	ns   string
-}
-
-var grafanadatasourcesResource = v1beta1.SchemeGroupVersion.WithResource("grafanadatasources")
-
-var grafanadatasourcesKind = v1beta1.SchemeGroupVersion.WithKind("GrafanaDatasource")
-
-// Get takes name of the grafanaDatasource, and returns the corresponding grafanaDatasource object, and an error if there is any.
-func (c *FakeGrafanaDatasources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.GrafanaDatasource, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewGetAction(grafanadatasourcesResource, c.ns, name), &v1beta1.GrafanaDatasource{})
-
-	if obj == nil {
-		return nil, err
-	}
-	return obj.(*v1beta1.GrafanaDatasource), err
 }
 
-// List takes label and field selectors, and returns the list of GrafanaDatasources that match those selectors.
-func (c *FakeGrafanaDatasources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.GrafanaDatasourceList, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewListAction(grafanadatasourcesResource, grafanadatasourcesKind, c.ns, opts), &v1beta1.GrafanaDatasourceList{})
-
-	if obj == nil {
-		return nil, err
-	}
-
-	label, _, _ := testing.ExtractFromListOptions(opts)
-	if label == nil {
-		label = labels.Everything()
-	}
-	list := &v1beta1.GrafanaDatasourceList{ListMeta: obj.(*v1beta1.GrafanaDatasourceList).ListMeta}
-	for _, item := range obj.(*v1beta1.GrafanaDatasourceList).Items {
-		if label.Matches(labels.Set(item.Labels)) {
-			list.Items = append(list.Items, item)
-		}
-	}
-	return list, err
-}
-
-// Watch returns a watch.Interface that watches the requested grafanaDatasources.
-func (c *FakeGrafanaDatasources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
-	return c.Fake.
-		InvokesWatch(testing.NewWatchAction(grafanadatasourcesResource, c.ns, opts))
-
-}
-
-// Create takes the representation of a grafanaDatasource and creates it.  Returns the server's representation of the grafanaDatasource, and an error, if there is any.
-func (c *FakeGrafanaDatasources) Create(ctx context.Context, grafanaDatasource *v1beta1.GrafanaDatasource, opts v1.CreateOptions) (result *v1beta1.GrafanaDatasource, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewCreateAction(grafanadatasourcesResource, c.ns, grafanaDatasource), &v1beta1.GrafanaDatasource{})
-
-	if obj == nil {
-		return nil, err
-	}
-	return obj.(*v1beta1.GrafanaDatasource), err
-}
-
-// Update takes the representation of a grafanaDatasource and updates it. Returns the server's representation of the grafanaDatasource, and an error, if there is any.
-func (c *FakeGrafanaDatasources) Update(ctx context.Context, grafanaDatasource *v1beta1.GrafanaDatasource, opts v1.UpdateOptions) (result *v1beta1.GrafanaDatasource, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateAction(grafanadatasourcesResource, c.ns, grafanaDatasource), &v1beta1.GrafanaDatasource{})
-
-	if obj == nil {
-		return nil, err
-	}
-	return obj.(*v1beta1.GrafanaDatasource), err
-}
-
-// UpdateStatus was generated because the type contains a Status member.
-// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *FakeGrafanaDatasources) UpdateStatus(ctx context.Context, grafanaDatasource *v1beta1.GrafanaDatasource, opts v1.UpdateOptions) (*v1beta1.GrafanaDatasource, error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewUpdateSubresourceAction(grafanadatasourcesResource, "status", c.ns, grafanaDatasource), &v1beta1.GrafanaDatasource{})
-
-	if obj == nil {
-		return nil, err
-	}
-	return obj.(*v1beta1.GrafanaDatasource), err
-}
-
-// Delete takes name of the grafanaDatasource and deletes it. Returns an error if one occurs.
-func (c *FakeGrafanaDatasources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
-	_, err := c.Fake.
-		Invokes(testing.NewDeleteActionWithOptions(grafanadatasourcesResource, c.ns, name, opts), &v1beta1.GrafanaDatasource{})
-
-	return err
-}
-
-// DeleteCollection deletes a collection of objects.
-func (c *FakeGrafanaDatasources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
-	action := testing.NewDeleteCollectionAction(grafanadatasourcesResource, c.ns, listOpts)
-
-	_, err := c.Fake.Invokes(action, &v1beta1.GrafanaDatasourceList{})
-	return err
-}
-
-// Patch applies the patch and returns the patched grafanaDatasource.
-func (c *FakeGrafanaDatasources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GrafanaDatasource, err error) {
-	obj, err := c.Fake.
-		Invokes(testing.NewPatchSubresourceAction(grafanadatasourcesResource, c.ns, name, pt, data, subresources...), &v1beta1.GrafanaDatasource{})
-
-	if obj == nil {
-		return nil, err
+func newFakeGrafanaDatasources(fake *FakeGrafanaIntegreatlyV1beta1, namespace string) typedoperatorv1beta1.GrafanaDatasourceInterface {
+	return &fakeGrafanaDatasources{
+		gentype.NewFakeClientWithListAndApply[*v1beta1.GrafanaDatasource, *v1beta1.GrafanaDatasourceList, *operatorv1beta1.GrafanaDatasourceApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1beta1.SchemeGroupVersion.WithResource("grafanadatasources"),
+			v1beta1.SchemeGroupVersion.WithKind("GrafanaDatasource"),
+			func() *v1beta1.GrafanaDatasource { return &v1beta1.GrafanaDatasource{} },
+			func() *v1beta1.GrafanaDatasourceList { return &v1beta1.GrafanaDatasourceList{} },
+			func(dst, src *v1beta1.GrafanaDatasourceList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.GrafanaDatasourceList) []*v1beta1.GrafanaDatasource {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.GrafanaDatasourceList, items []*v1beta1.GrafanaDatasource) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
 	}
-	return obj.(*v1beta1.GrafanaDatasource), err
 }
@@ -13,6 +13,7 @@ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 See the License for the specific language governing permissions and
 limitations under the License.
 */
+
 // Code generated by client-gen. DO NOT EDIT.
 
 package fake
@@ -28,27 +29,27 @@ type FakeGrafanaIntegreatlyV1beta1 struct {
 }
 
 func (c *FakeGrafanaIntegreatlyV1beta1) Grafanas(namespace string) v1beta1.GrafanaInterface {
-	return &FakeGrafanas{c, namespace}
+	return newFakeGrafanas(c, namespace)
 }
 
 func (c *FakeGrafanaIntegreatlyV1beta1) GrafanaAlertRuleGroups(namespace string) v1beta1.GrafanaAlertRuleGroupInterface {
-	return &FakeGrafanaAlertRuleGroups{c, namespace}
+	return newFakeGrafanaAlertRuleGroups(c, namespace)
 }
 
 func (c *FakeGrafanaIntegreatlyV1beta1) GrafanaContactPoints(namespace string) v1beta1.GrafanaContactPointInterface {
-	return &FakeGrafanaContactPoints{c, namespace}
+	return newFakeGrafanaContactPoints(c, namespace)
 }
 
 func (c *FakeGrafanaIntegreatlyV1beta1) GrafanaDashboards(namespace string) v1beta1.GrafanaDashboardInterface {
-	return &FakeGrafanaDashboards{c, namespace}
+	return newFakeGrafanaDashboards(c, namespace)
 }
 
 func (c *FakeGrafanaIntegreatlyV1beta1) GrafanaDatasources(namespace string) v1beta1.GrafanaDatasourceInterface {
-	return &FakeGrafanaDatasources{c, namespace}
+	return newFakeGrafanaDatasources(c, namespace)
 }
 
 func (c *FakeGrafanaIntegreatlyV1beta1) GrafanaFolders(namespace string) v1beta1.GrafanaFolderInterface {
-	return &FakeGrafanaFolders{c, namespace}
+	return newFakeGrafanaFolders(c, namespace)
 }
 
 // RESTClient returns a RESTClient that is used to communicate
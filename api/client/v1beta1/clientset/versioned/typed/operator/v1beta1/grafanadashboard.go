@@ -13,20 +13,21 @@ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 See the License for the specific language governing permissions and
 limitations under the License.
 */
+
 // Code generated by client-gen. DO NOT EDIT.
 
 package v1beta1
 
 import (
-	"context"
-	"time"
+	context "context"
 
+	applyconfigurationoperatorv1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/applyconfiguration/operator/v1beta1"
 	scheme "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/clientset/versioned/scheme"
-	v1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	operatorv1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
-	rest "k8s.io/client-go/rest"
+	gentype "k8s.io/client-go/gentype"
 )
 
 // GrafanaDashboardsGetter has a method to return a GrafanaDashboardInterface.
@@ -37,158 +38,37 @@ type GrafanaDashboardsGetter interface {
 
 // GrafanaDashboardInterface has methods to work with GrafanaDashboard resources.
 type GrafanaDashboardInterface interface {
-	Create(ctx context.Context, grafanaDashboard *v1beta1.GrafanaDashboard, opts v1.CreateOptions) (*v1beta1.GrafanaDashboard, error)
-	Update(ctx context.Context, grafanaDashboard *v1beta1.GrafanaDashboard, opts v1.UpdateOptions) (*v1beta1.GrafanaDashboard, error)
-	UpdateStatus(ctx context.Context, grafanaDashboard *v1beta1.GrafanaDashboard, opts v1.UpdateOptions) (*v1beta1.GrafanaDashboard, error)
+	Create(ctx context.Context, grafanaDashboard *operatorv1beta1.GrafanaDashboard, opts v1.CreateOptions) (*operatorv1beta1.GrafanaDashboard, error)
+	Update(ctx context.Context, grafanaDashboard *operatorv1beta1.GrafanaDashboard, opts v1.UpdateOptions) (*operatorv1beta1.GrafanaDashboard, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, grafanaDashboard *operatorv1beta1.GrafanaDashboard, opts v1.UpdateOptions) (*operatorv1beta1.GrafanaDashboard, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
-	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.GrafanaDashboard, error)
-	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.GrafanaDashboardList, error)
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*operatorv1beta1.GrafanaDashboard, error)
+	List(ctx context.Context, opts v1.ListOptions) (*operatorv1beta1.GrafanaDashboardList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
-	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GrafanaDashboard, err error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *operatorv1beta1.GrafanaDashboard, err error)
+	Apply(ctx context.Context, grafanaDashboard *applyconfigurationoperatorv1beta1.GrafanaDashboardApplyConfiguration, opts v1.ApplyOptions) (result *operatorv1beta1.GrafanaDashboard, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, grafanaDashboard *applyconfigurationoperatorv1beta1.GrafanaDashboardApplyConfiguration, opts v1.ApplyOptions) (result *operatorv1beta1.GrafanaDashboard, err error)
 	GrafanaDashboardExpansion
 }
 
 // grafanaDashboards implements GrafanaDashboardInterface
 type grafanaDashboards struct {
-	client rest.Interface
-	ns     string
+	*gentype.ClientWithListAndApply[*operatorv1beta1.GrafanaDashboard, *operatorv1beta1.GrafanaDashboardList, *applyconfigurationoperatorv1beta1.GrafanaDashboardApplyConfiguration]
 }
 
 // newGrafanaDashboards returns a GrafanaDashboards
 func newGrafanaDashboards(c *GrafanaIntegreatlyV1beta1Client, namespace string) *grafanaDashboards {
 	return &grafanaDashboards{
-		client: c.RESTClient(),
-		ns:     namespace,
-	}
-}
-
-// Get takes name of the grafanaDashboard, and returns the corresponding grafanaDashboard object, and an error if there is any.
-func (c *grafanaDashboards) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.GrafanaDashboard, err error) {
-	result = &v1beta1.GrafanaDashboard{}
-	err = c.client.Get().
-		Namespace(c.ns).
-		Resource("grafanadashboards").
-		Name(name).
-		VersionedParams(&options, scheme.ParameterCodec).
-		Do(ctx).
-		Into(result)
-	return
-}
-
-// List takes label and field selectors, and returns the list of GrafanaDashboards that match those selectors.
-func (c *grafanaDashboards) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.GrafanaDashboardList, err error) {
-	var timeout time.Duration
-	if opts.TimeoutSeconds != nil {
-		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
-	}
-	result = &v1beta1.GrafanaDashboardList{}
-	err = c.client.Get().
-		Namespace(c.ns).
-		Resource("grafanadashboards").
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Timeout(timeout).
-		Do(ctx).
-		Into(result)
-	return
-}
-
-// Watch returns a watch.Interface that watches the requested grafanaDashboards.
-func (c *grafanaDashboards) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
-	var timeout time.Duration
-	if opts.TimeoutSeconds != nil {
-		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
-	}
-	opts.Watch = true
-	return c.client.Get().
-		Namespace(c.ns).
-		Resource("grafanadashboards").
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Timeout(timeout).
-		Watch(ctx)
-}
-
-// Create takes the representation of a grafanaDashboard and creates it.  Returns the server's representation of the grafanaDashboard, and an error, if there is any.
-func (c *grafanaDashboards) Create(ctx context.Context, grafanaDashboard *v1beta1.GrafanaDashboard, opts v1.CreateOptions) (result *v1beta1.GrafanaDashboard, err error) {
-	result = &v1beta1.GrafanaDashboard{}
-	err = c.client.Post().
-		Namespace(c.ns).
-		Resource("grafanadashboards").
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Body(grafanaDashboard).
-		Do(ctx).
-		Into(result)
-	return
-}
-
-// Update takes the representation of a grafanaDashboard and updates it. Returns the server's representation of the grafanaDashboard, and an error, if there is any.
-func (c *grafanaDashboards) Update(ctx context.Context, grafanaDashboard *v1beta1.GrafanaDashboard, opts v1.UpdateOptions) (result *v1beta1.GrafanaDashboard, err error) {
-	result = &v1beta1.GrafanaDashboard{}
-	err = c.client.Put().
-		Namespace(c.ns).
-		Resource("grafanadashboards").
-		Name(grafanaDashboard.Name).
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Body(grafanaDashboard).
-		Do(ctx).
-		Into(result)
-	return
-}
-
-// UpdateStatus was generated because the type contains a Status member.
-// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *grafanaDashboards) UpdateStatus(ctx context.Context, grafanaDashboard *v1beta1.GrafanaDashboard, opts v1.UpdateOptions) (result *v1beta1.GrafanaDashboard, err error) {
-	result = &v1beta1.GrafanaDashboard{}
-	err = c.client.Put().
-		Namespace(c.ns).
-		Resource("grafanadashboards").
-		Name(grafanaDashboard.Name).
-		SubResource("status").
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Body(grafanaDashboard).
-		Do(ctx).
-		Into(result)
-	return
-}
-
-// Delete takes name of the grafanaDashboard and deletes it. Returns an error if one occurs.
-func (c *grafanaDashboards) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
-	return c.client.Delete().
-		Namespace(c.ns).
-		Resource("grafanadashboards").
-		Name(name).
-		Body(&opts).
-		Do(ctx).
-		Error()
-}
-
-// DeleteCollection deletes a collection of objects.
-func (c *grafanaDashboards) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
-	var timeout time.Duration
-	if listOpts.TimeoutSeconds != nil {
-		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+		gentype.NewClientWithListAndApply[*operatorv1beta1.GrafanaDashboard, *operatorv1beta1.GrafanaDashboardList, *applyconfigurationoperatorv1beta1.GrafanaDashboardApplyConfiguration](
+			"grafanadashboards",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *operatorv1beta1.GrafanaDashboard { return &operatorv1beta1.GrafanaDashboard{} },
+			func() *operatorv1beta1.GrafanaDashboardList { return &operatorv1beta1.GrafanaDashboardList{} },
+		),
 	}
-	return c.client.Delete().
-		Namespace(c.ns).
-		Resource("grafanadashboards").
-		VersionedParams(&listOpts, scheme.ParameterCodec).
-		Timeout(timeout).
-		Body(&opts).
-		Do(ctx).
-		Error()
-}
-
-// Patch applies the patch and returns the patched grafanaDashboard.
-func (c *grafanaDashboards) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GrafanaDashboard, err error) {
-	result = &v1beta1.GrafanaDashboard{}
-	err = c.client.Patch(pt).
-		Namespace(c.ns).
-		Resource("grafanadashboards").
-		Name(name).
-		SubResource(subresources...).
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Body(data).
-		Do(ctx).
-		Into(result)
-	return
 }
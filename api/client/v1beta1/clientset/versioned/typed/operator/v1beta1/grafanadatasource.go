@@ -13,20 +13,21 @@ WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
 See the License for the specific language governing permissions and
 limitations under the License.
 */
+
 // Code generated by client-gen. DO NOT EDIT.
 
 package v1beta1
 
 import (
-	"context"
-	"time"
+	context "context"
 
+	applyconfigurationoperatorv1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/applyconfiguration/operator/v1beta1"
 	scheme "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/clientset/versioned/scheme"
-	v1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	operatorv1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	watch "k8s.io/apimachinery/pkg/watch"
-	rest "k8s.io/client-go/rest"
+	gentype "k8s.io/client-go/gentype"
 )
 
 // GrafanaDatasourcesGetter has a method to return a GrafanaDatasourceInterface.
@@ -37,158 +38,37 @@ type GrafanaDatasourcesGetter interface {
 
 // GrafanaDatasourceInterface has methods to work with GrafanaDatasource resources.
 type GrafanaDatasourceInterface interface {
-	Create(ctx context.Context, grafanaDatasource *v1beta1.GrafanaDatasource, opts v1.CreateOptions) (*v1beta1.GrafanaDatasource, error)
-	Update(ctx context.Context, grafanaDatasource *v1beta1.GrafanaDatasource, opts v1.UpdateOptions) (*v1beta1.GrafanaDatasource, error)
-	UpdateStatus(ctx context.Context, grafanaDatasource *v1beta1.GrafanaDatasource, opts v1.UpdateOptions) (*v1beta1.GrafanaDatasource, error)
+	Create(ctx context.Context, grafanaDatasource *operatorv1beta1.GrafanaDatasource, opts v1.CreateOptions) (*operatorv1beta1.GrafanaDatasource, error)
+	Update(ctx context.Context, grafanaDatasource *operatorv1beta1.GrafanaDatasource, opts v1.UpdateOptions) (*operatorv1beta1.GrafanaDatasource, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, grafanaDatasource *operatorv1beta1.GrafanaDatasource, opts v1.UpdateOptions) (*operatorv1beta1.GrafanaDatasource, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
-	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.GrafanaDatasource, error)
-	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.GrafanaDatasourceList, error)
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*operatorv1beta1.GrafanaDatasource, error)
+	List(ctx context.Context, opts v1.ListOptions) (*operatorv1beta1.GrafanaDatasourceList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
-	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GrafanaDatasource, err error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *operatorv1beta1.GrafanaDatasource, err error)
+	Apply(ctx context.Context, grafanaDatasource *applyconfigurationoperatorv1beta1.GrafanaDatasourceApplyConfiguration, opts v1.ApplyOptions) (result *operatorv1beta1.GrafanaDatasource, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, grafanaDatasource *applyconfigurationoperatorv1beta1.GrafanaDatasourceApplyConfiguration, opts v1.ApplyOptions) (result *operatorv1beta1.GrafanaDatasource, err error)
 	GrafanaDatasourceExpansion
 }
 
 // grafanaDatasources implements GrafanaDatasourceInterface
 type grafanaDatasources struct {
-	client rest.Interface
-	ns     string
+	*gentype.ClientWithListAndApply[*operatorv1beta1.GrafanaDatasource, *operatorv1beta1.GrafanaDatasourceList, *applyconfigurationoperatorv1beta1.GrafanaDatasourceApplyConfiguration]
 }
 
 // newGrafanaDatasources returns a GrafanaDatasources
 func newGrafanaDatasources(c *GrafanaIntegreatlyV1beta1Client, namespace string) *grafanaDatasources {
 	return &grafanaDatasources{
-		client: c.RESTClient(),
-		ns:     namespace,
-	}
-}
-
-// Get takes name of the grafanaDatasource, and returns the corresponding grafanaDatasource object, and an error if there is any.
-func (c *grafanaDatasources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.GrafanaDatasource, err error) {
-	result = &v1beta1.GrafanaDatasource{}
-	err = c.client.Get().
-		Namespace(c.ns).
-		Resource("grafanadatasources").
-		Name(name).
-		VersionedParams(&options, scheme.ParameterCodec).
-		Do(ctx).
-		Into(result)
-	return
-}
-
-// List takes label and field selectors, and returns the list of GrafanaDatasources that match those selectors.
-func (c *grafanaDatasources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.GrafanaDatasourceList, err error) {
-	var timeout time.Duration
-	if opts.TimeoutSeconds != nil {
-		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
-	}
-	result = &v1beta1.GrafanaDatasourceList{}
-	err = c.client.Get().
-		Namespace(c.ns).
-		Resource("grafanadatasources").
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Timeout(timeout).
-		Do(ctx).
-		Into(result)
-	return
-}
-
-// Watch returns a watch.Interface that watches the requested grafanaDatasources.
-func (c *grafanaDatasources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
-	var timeout time.Duration
-	if opts.TimeoutSeconds != nil {
-		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
-	}
-	opts.Watch = true
-	return c.client.Get().
-		Namespace(c.ns).
-		Resource("grafanadatasources").
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Timeout(timeout).
-		Watch(ctx)
-}
-
-// Create takes the representation of a grafanaDatasource and creates it.  Returns the server's representation of the grafanaDatasource, and an error, if there is any.
-func (c *grafanaDatasources) Create(ctx context.Context, grafanaDatasource *v1beta1.GrafanaDatasource, opts v1.CreateOptions) (result *v1beta1.GrafanaDatasource, err error) {
-	result = &v1beta1.GrafanaDatasource{}
-	err = c.client.Post().
-		Namespace(c.ns).
-		Resource("grafanadatasources").
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Body(grafanaDatasource).
-		Do(ctx).
-		Into(result)
-	return
-}
-
-// Update takes the representation of a grafanaDatasource and updates it. Returns the server's representation of the grafanaDatasource, and an error, if there is any.
-func (c *grafanaDatasources) Update(ctx context.Context, grafanaDatasource *v1beta1.GrafanaDatasource, opts v1.UpdateOptions) (result *v1beta1.GrafanaDatasource, err error) {
-	result = &v1beta1.GrafanaDatasource{}
-	err = c.client.Put().
-		Namespace(c.ns).
-		Resource("grafanadatasources").
-		Name(grafanaDatasource.Name).
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Body(grafanaDatasource).
-		Do(ctx).
-		Into(result)
-	return
-}
-
-// UpdateStatus was generated because the type contains a Status member.
-// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
-func (c *grafanaDatasources) UpdateStatus(ctx context.Context, grafanaDatasource *v1beta1.GrafanaDatasource, opts v1.UpdateOptions) (result *v1beta1.GrafanaDatasource, err error) {
-	result = &v1beta1.GrafanaDatasource{}
-	err = c.client.Put().
-		Namespace(c.ns).
-		Resource("grafanadatasources").
-		Name(grafanaDatasource.Name).
-		SubResource("status").
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Body(grafanaDatasource).
-		Do(ctx).
-		Into(result)
-	return
-}
-
-// Delete takes name of the grafanaDatasource and deletes it. Returns an error if one occurs.
-func (c *grafanaDatasources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
-	return c.client.Delete().
-		Namespace(c.ns).
-		Resource("grafanadatasources").
-		Name(name).
-		Body(&opts).
-		Do(ctx).
-		Error()
-}
-
-// DeleteCollection deletes a collection of objects.
-func (c *grafanaDatasources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
-	var timeout time.Duration
-	if listOpts.TimeoutSeconds != nil {
-		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+		gentype.NewClientWithListAndApply[*operatorv1beta1.GrafanaDatasource, *operatorv1beta1.GrafanaDatasourceList, *applyconfigurationoperatorv1beta1.GrafanaDatasourceApplyConfiguration](
+			"grafanadatasources",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *operatorv1beta1.GrafanaDatasource { return &operatorv1beta1.GrafanaDatasource{} },
+			func() *operatorv1beta1.GrafanaDatasourceList { return &operatorv1beta1.GrafanaDatasourceList{} },
+		),
 	}
-	return c.client.Delete().
-		Namespace(c.ns).
-		Resource("grafanadatasources").
-		VersionedParams(&listOpts, scheme.ParameterCodec).
-		Timeout(timeout).
-		Body(&opts).
-		Do(ctx).
-		Error()
-}
-
-// Patch applies the patch and returns the patched grafanaDatasource.
-func (c *grafanaDatasources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GrafanaDatasource, err error) {
-	result = &v1beta1.GrafanaDatasource{}
-	err = c.client.Patch(pt).
-		Namespace(c.ns).
-		Resource("grafanadatasources").
-		Name(name).
-		SubResource(subresources...).
-		VersionedParams(&opts, scheme.ParameterCodec).
-		Body(data).
-		Do(ctx).
-		Into(result)
-	return
 }
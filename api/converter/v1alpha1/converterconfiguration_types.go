@@ -0,0 +1,403 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	operatorv1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ConverterConfigurationKind = "ConverterConfiguration"
+
+	// ConverterConfigurationName is the name of the only ConverterConfiguration the converter reads
+	ConverterConfigurationName = "default"
+
+	// ConditionLoaded reports whether the spec was loaded by the converter
+	ConditionLoaded = "Loaded"
+	// ConditionActive reports whether the converter converts any kind
+	ConditionActive = "Active"
+)
+
+// EnabledConverters defines which kinds of integreatly.org/v1alpha1 objects are converted
+// +k8s:openapi-gen=true
+type EnabledConverters struct {
+	// +optional
+	Dashboard bool `json:"dashboard,omitempty"`
+	// +optional
+	Datasource bool `json:"datasource,omitempty"`
+	// +optional
+	Folder bool `json:"folder,omitempty"`
+	// +optional
+	NotificationChannel bool `json:"notification,omitempty"`
+}
+
+// DeletionPolicy defines what happens with converted objects when their source is deleted
+// +kubebuilder:validation:Enum=orphan;delete;ownerReference
+type DeletionPolicy string
+
+// DeletionPolicies defines per kind what happens with converted objects when their source is deleted
+// +k8s:openapi-gen=true
+type DeletionPolicies struct {
+	// +optional
+	Dashboard DeletionPolicy `json:"dashboard,omitempty"`
+	// +optional
+	Datasource DeletionPolicy `json:"datasource,omitempty"`
+	// +optional
+	Folder DeletionPolicy `json:"folder,omitempty"`
+	// +optional
+	NotificationChannel DeletionPolicy `json:"notification,omitempty"`
+}
+
+// Workers defines per kind how many sources are converted concurrently, 0 means one worker
+// +k8s:openapi-gen=true
+type Workers struct {
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Dashboard int `json:"dashboard,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Datasource int `json:"datasource,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Folder int `json:"folder,omitempty"`
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	NotificationChannel int `json:"notification,omitempty"`
+}
+
+// InstanceSelectorRule routes integreatly.org/v1alpha1 objects to Grafana instances,
+// it matches objects which meet all its conditions
+// +k8s:openapi-gen=true
+type InstanceSelectorRule struct {
+	// Namespaces are namespaces of matched objects, empty matches objects of all namespaces
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Kinds are kinds of matched objects, empty matches all kinds
+	// +kubebuilder:validation:items:Enum=dashboard;datasource;folder;notification
+	// +optional
+	Kinds []string `json:"kinds,omitempty"`
+	// Selector is the label selector of matched objects, empty matches all objects
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// InstanceSelector selects Grafana instances of objects converted from matched objects
+	InstanceSelector *metav1.LabelSelector `json:"instanceSelector"`
+}
+
+// Tenant pairs namespaces of integreatly.org/v1alpha1 objects with the namespace of their Grafana instances
+// +k8s:openapi-gen=true
+type Tenant struct {
+	// GrafanaNamespace is the namespace of Grafana instances of the tenant
+	GrafanaNamespace string `json:"grafanaNamespace"`
+	// Namespaces are namespaces of objects whose converted objects may be placed in GrafanaNamespace
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// TargetNamespaceRule maps integreatly.org/v1alpha1 objects to a namespace, it matches objects which meet all its conditions
+// +k8s:openapi-gen=true
+type TargetNamespaceRule struct {
+	// Namespaces are namespaces of matched objects, empty matches objects of all namespaces
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+	// Kinds are kinds of matched objects, empty matches all kinds
+	// +kubebuilder:validation:items:Enum=dashboard;datasource;folder;notification
+	// +optional
+	Kinds []string `json:"kinds,omitempty"`
+	// Selector is the label selector of matched objects, empty matches all objects
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Namespace is the template of the namespace of objects converted from matched objects
+	Namespace string `json:"namespace"`
+}
+
+// TargetNamespace maps integreatly.org/v1alpha1 objects to namespaces of their converted objects,
+// namespaces and name prefixes are Go templates of the object
+// +k8s:openapi-gen=true
+type TargetNamespace struct {
+	// Namespace is the namespace of converted objects, empty keeps them in the namespace of their source
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Rules map matched objects to namespaces, the first matching rule wins over Namespace
+	// +optional
+	Rules []TargetNamespaceRule `json:"rules,omitempty"`
+	// NamePrefix is prepended to names of objects converted into another namespace than the one of their source
+	// +optional
+	NamePrefix string `json:"namePrefix,omitempty"`
+	// AllowedNamespaces are namespaces the target namespace annotation of objects may set besides the namespace
+	// of the object and the one the configuration maps it to
+	// +optional
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty"`
+}
+
+// KindDefaults defines fields of converted objects of one kind which integreatly.org/v1alpha1 objects do not set
+// +k8s:openapi-gen=true
+type KindDefaults struct {
+	// ResyncPeriod is how often the Grafana operator syncs converted objects
+	// +optional
+	ResyncPeriod *metav1.Duration `json:"resyncPeriod,omitempty"`
+	// AllowCrossNamespaceImport overrides whether Grafana instances of other namespaces import converted objects
+	// +optional
+	AllowCrossNamespaceImport *bool `json:"allowCrossNamespaceImport,omitempty"`
+	// Labels are added to converted objects, labels of sources take precedence
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are added to converted objects, annotations of sources take precedence
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// DashboardDefaults defines fields of converted dashboards which integreatly.org/v1alpha1 dashboards do not set
+// +k8s:openapi-gen=true
+type DashboardDefaults struct {
+	KindDefaults `json:",inline"`
+	// ContentCacheDuration is used for dashboards which do not set contentCacheDuration
+	// and are not imported by a legacy Grafana which sets dashboardContentCacheDuration
+	// +optional
+	ContentCacheDuration *metav1.Duration `json:"contentCacheDuration,omitempty"`
+	// Envs are environment variables of converted dashboards
+	// +optional
+	Envs []operatorv1beta1.GrafanaDashboardEnv `json:"envs,omitempty"`
+}
+
+// Defaults defines per kind fields of converted objects which integreatly.org/v1alpha1 objects do not set
+// +k8s:openapi-gen=true
+type Defaults struct {
+	// +optional
+	Dashboard DashboardDefaults `json:"dashboard,omitempty"`
+	// +optional
+	Datasource KindDefaults `json:"datasource,omitempty"`
+	// +optional
+	Folder KindDefaults `json:"folder,omitempty"`
+	// +optional
+	NotificationChannel KindDefaults `json:"notification,omitempty"`
+}
+
+// KeyMatcher matches keys of labels or annotations, exactly one of its fields has to be set
+// +k8s:openapi-gen=true
+type KeyMatcher struct {
+	// Key matches the key itself
+	// +optional
+	Key string `json:"key,omitempty"`
+	// Prefix matches keys which start with it
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// Regex matches keys which match the whole regular expression
+	// +optional
+	Regex string `json:"regex,omitempty"`
+}
+
+// PropagationRules define which labels or annotations of integreatly.org/v1alpha1 objects are copied to converted objects
+// +k8s:openapi-gen=true
+type PropagationRules struct {
+	// Allow lists keys which are copied, all keys are copied if it is empty
+	// +optional
+	Allow []KeyMatcher `json:"allow,omitempty"`
+	// Deny lists keys which are not copied, it takes precedence over Allow
+	// +optional
+	Deny []KeyMatcher `json:"deny,omitempty"`
+	// Add sets keys of all converted objects, they take precedence over keys of sources
+	// +optional
+	Add map[string]string `json:"add,omitempty"`
+}
+
+// Propagation defines how labels and annotations of integreatly.org/v1alpha1 objects are copied to converted objects
+// +k8s:openapi-gen=true
+type Propagation struct {
+	// +optional
+	Labels PropagationRules `json:"labels,omitempty"`
+	// +optional
+	Annotations PropagationRules `json:"annotations,omitempty"`
+	// KeepToolTracking copies labels and annotations deployment tools track their objects with
+	// +optional
+	KeepToolTracking bool `json:"keepToolTracking,omitempty"`
+}
+
+// SourceSelectors defines per kind label selectors of integreatly.org/v1alpha1 objects which are converted
+// +k8s:openapi-gen=true
+type SourceSelectors struct {
+	// +optional
+	Dashboard *metav1.LabelSelector `json:"dashboard,omitempty"`
+	// +optional
+	Datasource *metav1.LabelSelector `json:"datasource,omitempty"`
+	// +optional
+	Folder *metav1.LabelSelector `json:"folder,omitempty"`
+	// +optional
+	NotificationChannel *metav1.LabelSelector `json:"notification,omitempty"`
+}
+
+// SourceFilters defines per kind CEL expressions which integreatly.org/v1alpha1 objects have to pass to be converted,
+// the object is available in expressions as the object variable
+// +k8s:openapi-gen=true
+type SourceFilters struct {
+	// +optional
+	Dashboard string `json:"dashboard,omitempty"`
+	// +optional
+	Datasource string `json:"datasource,omitempty"`
+	// +optional
+	Folder string `json:"folder,omitempty"`
+	// +optional
+	NotificationChannel string `json:"notification,omitempty"`
+}
+
+// ConverterConfigurationSpec defines the converter configuration, it mirrors parameters.yaml of the converter
+// +k8s:openapi-gen=true
+type ConverterConfigurationSpec struct {
+	// Enable turns the converter on
+	// +optional
+	Enable bool `json:"enable,omitempty"`
+	// Strategy defines how converted objects are kept in sync with sources
+	// +kubebuilder:validation:Enum=createOnly;sync;mirror;oneShot
+	// +optional
+	Strategy string `json:"strategy,omitempty"`
+	// InstanceSelector selects Grafana instances of converted objects
+	// +optional
+	InstanceSelector *metav1.LabelSelector `json:"instanceSelector,omitempty"`
+	// InstanceSelectorMode defines whether instance selectors of dashboards are derived from legacy integreatly.org/v1alpha1 Grafanas
+	// +kubebuilder:validation:Enum=static;legacyGrafana
+	// +optional
+	InstanceSelectorMode string `json:"instanceSelectorMode,omitempty"`
+	// InstanceSelectorRules route converted objects to Grafana instances, the first matching rule wins over InstanceSelector
+	// +optional
+	InstanceSelectorRules []InstanceSelectorRule `json:"instanceSelectorRules,omitempty"`
+	// IsolationMode defines whether Grafana instances may import converted objects of other namespaces
+	// +kubebuilder:validation:Enum=none;tenant
+	// +optional
+	IsolationMode string `json:"isolationMode,omitempty"`
+	// Tenants pair namespaces of sources with namespaces of Grafana instances their converted objects may be placed in
+	// +optional
+	Tenants []Tenant `json:"tenants,omitempty"`
+	// DeletionPolicy defines per kind what happens with converted objects when their source is deleted
+	// +optional
+	DeletionPolicy DeletionPolicies `json:"deletionPolicy,omitempty"`
+	// DriftPolicy defines what happens when a converted object is changed or deleted by somebody else
+	// +kubebuilder:validation:Enum=ignore;report;restore
+	// +optional
+	DriftPolicy string `json:"driftPolicy,omitempty"`
+	// AdoptionPolicy defines whether the converter takes over existing objects it did not create
+	// +kubebuilder:validation:Enum=never;ifAnnotated;always
+	// +optional
+	AdoptionPolicy string `json:"adoptionPolicy,omitempty"`
+	// Workers defines per kind how many sources are converted concurrently
+	// +optional
+	Workers Workers `json:"workers,omitempty"`
+	// SourceSelector defines per kind label selectors of converted objects, objects are selected by the API server
+	// +optional
+	SourceSelector SourceSelectors `json:"sourceSelector,omitempty"`
+	// SourceFilter defines per kind CEL expressions which converted objects have to pass
+	// +optional
+	SourceFilter SourceFilters `json:"sourceFilter,omitempty"`
+	// TargetNamespace maps sources to namespaces of converted objects
+	// +optional
+	TargetNamespace TargetNamespace `json:"targetNamespace,omitempty"`
+	// Defaults defines per kind fields of converted objects which sources do not set
+	// +optional
+	Defaults Defaults `json:"defaults,omitempty"`
+	// Propagation defines which labels and annotations of sources are copied to converted objects
+	// +optional
+	Propagation Propagation `json:"propagation,omitempty"`
+	// FolderTitle is the Grafana folder of dashboards which do not set customFolderName
+	// +optional
+	FolderTitle string `json:"folderTitle,omitempty"`
+	// NamespaceOptIn converts only namespaces which opt in with an override
+	// +optional
+	NamespaceOptIn bool `json:"namespaceOptIn,omitempty"`
+
+	EnabledConverters `json:",inline"`
+}
+
+// ConverterConfigurationStatus defines the configuration the converter runs with
+// +k8s:openapi-gen=true
+type ConverterConfigurationStatus struct {
+	// ObservedGeneration is the generation of the spec the status was written for
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// ActiveConverters are kinds of integreatly.org/v1alpha1 objects the converter converts
+	// +optional
+	ActiveConverters []string `json:"activeConverters,omitempty"`
+	// WatchedNamespaces are namespaces the converter watches, empty means all namespaces
+	// +optional
+	WatchedNamespaces []string `json:"watchedNamespaces,omitempty"`
+	// LastLoadError is the error of the last spec the converter could not load, it is kept after later specs are loaded
+	// +optional
+	LastLoadError string `json:"lastLoadError,omitempty"`
+	// Namespaces are effective configurations of namespaces with overrides
+	// +listType=map
+	// +listMapKey=namespace
+	// +optional
+	Namespaces []NamespaceConfigurationStatus `json:"namespaces,omitempty"`
+	// Conditions are the Loaded and Active conditions of the configuration
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// NamespaceConfigurationStatus defines the effective configuration of a namespace with overrides
+// +k8s:openapi-gen=true
+type NamespaceConfigurationStatus struct {
+	// Namespace is the namespace of the overrides
+	Namespace string `json:"namespace"`
+	// Overrides are the namespace annotation and the ConfigMap which override the configuration in the namespace
+	// +optional
+	Overrides []string `json:"overrides,omitempty"`
+	// ActiveConverters are kinds of integreatly.org/v1alpha1 objects the converter converts in the namespace
+	// +optional
+	ActiveConverters []string `json:"activeConverters,omitempty"`
+	// InstanceSelector selects Grafana instances of objects converted in the namespace
+	// +optional
+	InstanceSelector *metav1.LabelSelector `json:"instanceSelector,omitempty"`
+	// FolderTitle is the Grafana folder of dashboards converted in the namespace
+	// +optional
+	FolderTitle string `json:"folderTitle,omitempty"`
+	// Error is the error of invalid overrides, nothing is converted in the namespace until they are fixed
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// ConverterConfiguration is the Schema for the converter configuration API,
+// the converter reads only the object named default and falls back to its configuration file without it
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:printcolumn:name="Loaded",type="string",JSONPath=".status.conditions[?(@.type==\"Loaded\")].status"
+// +kubebuilder:printcolumn:name="Converters",type="string",JSONPath=".status.activeConverters"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:validation:XValidation:rule="self.metadata.name == 'default'",message="the converter reads only the ConverterConfiguration named default"
+type ConverterConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConverterConfigurationSpec   `json:"spec,omitempty"`
+	Status ConverterConfigurationStatus `json:"status,omitempty"`
+}
+
+// ConverterConfigurationList contains a list of ConverterConfiguration
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ConverterConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConverterConfiguration `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ConverterConfiguration{}, &ConverterConfigurationList{})
+}
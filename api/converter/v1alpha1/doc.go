@@ -0,0 +1,5 @@
+// Package v1alpha1 contains API Schema definitions for the grafana-converter.qubership.org v1alpha1 API group
+// +k8s:deepcopy-gen=package,register
+// +groupName=grafana-converter.qubership.org
+// +groupGoName=Converter
+package v1alpha1
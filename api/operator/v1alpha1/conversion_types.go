@@ -0,0 +1,44 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// ConversionPhase is the state of the conversion of an object to grafana.integreatly.org/v1beta1
+type ConversionPhase string
+
+const (
+	// ConversionPhaseConverted - all grafana.integreatly.org/v1beta1 objects were generated from the current spec
+	ConversionPhaseConverted ConversionPhase = "Converted"
+	// ConversionPhaseFailed - the object or some of its parts could not be converted
+	ConversionPhaseFailed ConversionPhase = "Failed"
+)
+
+// ConversionStatus defines the state of the conversion of the object to grafana.integreatly.org/v1beta1,
+// it is written by grafana-operator-converter and kept apart from fields written by grafana-operator v4
+// +k8s:openapi-gen=true
+type ConversionStatus struct {
+	Phase   ConversionPhase `json:"phase,omitempty"`
+	Message string          `json:"message,omitempty"`
+	// ConvertedObjects are names of grafana.integreatly.org/v1beta1 objects generated from the object
+	// +optional
+	ConvertedObjects []string `json:"convertedObjects,omitempty"`
+	// LastError is the error of the last failed conversion, it is kept after later conversions succeed
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+	// ObservedGeneration is the generation of the object the status was written for
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
@@ -76,6 +76,8 @@ type GrafanaDashboardStatus struct {
 	ContentTimestamp metav1.Time            `json:"contentTimestamp,omitempty"`
 	ContentUrl       string                 `json:"contentUrl,omitempty"`
 	Error            *GrafanaDashboardError `json:"error,omitempty"`
+	// +optional
+	Conversion *ConversionStatus `json:"conversion,omitempty"`
 }
 
 // GrafanaDashboardError defines the error state of GrafanaDashboard
@@ -42,6 +42,8 @@ type GrafanaDataSourceSpec struct {
 type GrafanaDataSourceStatus struct {
 	Phase   StatusPhase `json:"phase"`
 	Message string      `json:"message"`
+	// +optional
+	Conversion *ConversionStatus `json:"conversion,omitempty"`
 }
 
 // GrafanaDataSource is the Schema for the grafanadatasources API
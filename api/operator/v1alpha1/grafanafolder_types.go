@@ -45,6 +45,13 @@ type GrafanaFolderSpec struct {
 	FolderPermissions []GrafanaPermissionItem `json:"permissions,omitempty"`
 }
 
+// GrafanaFolderStatus defines the observed state of GrafanaFolder
+// +k8s:openapi-gen=true
+type GrafanaFolderStatus struct {
+	// +optional
+	Conversion *ConversionStatus `json:"conversion,omitempty"`
+}
+
 // GrafanaFolder is the Schema for the grafana folders and folderpermissions API
 // GrafanaDashboard is the Schema for the grafanadashboards API
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -56,7 +63,8 @@ type GrafanaFolder struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec GrafanaFolderSpec `json:"spec,omitempty"`
+	Spec   GrafanaFolderSpec   `json:"spec,omitempty"`
+	Status GrafanaFolderStatus `json:"status,omitempty"`
 }
 
 // GrafanaFolderList contains a list of GrafanaFolder
@@ -24,6 +24,8 @@ type GrafanaNotificationChannelStatus struct {
 	ID      uint        `json:"id"`
 	Message string      `json:"message"`
 	Hash    string      `json:"hash"`
+	// +optional
+	Conversion *ConversionStatus `json:"conversion,omitempty"`
 }
 
 // GrafanaNotificationChannelRef Used to keep a notification channel reference without having access
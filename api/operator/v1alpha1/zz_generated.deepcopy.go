@@ -29,6 +29,26 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConversionStatus) DeepCopyInto(out *ConversionStatus) {
+	*out = *in
+	if in.ConvertedObjects != nil {
+		in, out := &in.ConvertedObjects, &out.ConvertedObjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConversionStatus.
+func (in *ConversionStatus) DeepCopy() *ConversionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConversionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Grafana) DeepCopyInto(out *Grafana) {
 	*out = *in
@@ -1624,6 +1644,11 @@ func (in *GrafanaDashboardStatus) DeepCopyInto(out *GrafanaDashboardStatus) {
 		*out = new(GrafanaDashboardError)
 		**out = **in
 	}
+	if in.Conversion != nil {
+		in, out := &in.Conversion, &out.Conversion
+		*out = new(ConversionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDashboardStatus.
@@ -1642,7 +1667,7 @@ func (in *GrafanaDataSource) DeepCopyInto(out *GrafanaDataSource) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDataSource.
@@ -1872,6 +1897,11 @@ func (in *GrafanaDataSourceSpec) DeepCopy() *GrafanaDataSourceSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GrafanaDataSourceStatus) DeepCopyInto(out *GrafanaDataSourceStatus) {
 	*out = *in
+	if in.Conversion != nil {
+		in, out := &in.Conversion, &out.Conversion
+		*out = new(ConversionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaDataSourceStatus.
@@ -2063,6 +2093,7 @@ func (in *GrafanaFolder) DeepCopyInto(out *GrafanaFolder) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaFolder.
@@ -2150,6 +2181,26 @@ func (in *GrafanaFolderSpec) DeepCopy() *GrafanaFolderSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaFolderStatus) DeepCopyInto(out *GrafanaFolderStatus) {
+	*out = *in
+	if in.Conversion != nil {
+		in, out := &in.Conversion, &out.Conversion
+		*out = new(ConversionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaFolderStatus.
+func (in *GrafanaFolderStatus) DeepCopy() *GrafanaFolderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaFolderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GrafanaHttpProxy) DeepCopyInto(out *GrafanaHttpProxy) {
 	*out = *in
@@ -2232,7 +2283,7 @@ func (in *GrafanaNotificationChannel) DeepCopyInto(out *GrafanaNotificationChann
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaNotificationChannel.
@@ -2318,6 +2369,11 @@ func (in *GrafanaNotificationChannelSpec) DeepCopy() *GrafanaNotificationChannel
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GrafanaNotificationChannelStatus) DeepCopyInto(out *GrafanaNotificationChannelStatus) {
 	*out = *in
+	if in.Conversion != nil {
+		in, out := &in.Conversion, &out.Conversion
+		*out = new(ConversionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaNotificationChannelStatus.
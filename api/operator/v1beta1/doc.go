@@ -1,4 +1,5 @@
 // Package v1beta1 contains API Schema definitions for the grafana v1beta1 API group
 // +k8s:deepcopy-gen=package,register
 // +groupName=grafana.integreatly.org
+// +groupGoName=GrafanaIntegreatly
 package v1beta1
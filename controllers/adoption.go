@@ -0,0 +1,76 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AdoptionPolicy defines whether the converter takes over existing v1beta1 objects it did not create
+type AdoptionPolicy string
+
+const (
+	// AdoptionPolicyNever - existing objects without the managed-by label are reported and left as is, it is the default policy
+	AdoptionPolicyNever AdoptionPolicy = "never"
+	// AdoptionPolicyIfAnnotated - existing objects are adopted only if their owners annotated them with the adopt annotation
+	AdoptionPolicyIfAnnotated AdoptionPolicy = "ifAnnotated"
+	// AdoptionPolicyAlways - all existing objects with the target name are adopted
+	AdoptionPolicyAlways AdoptionPolicy = "always"
+)
+
+const (
+	// adoptAnnotationKey is placed by owners on existing v1beta1 objects which the converter may adopt
+	adoptAnnotationKey = converterAnnotationPrefix + "adopt"
+	// previousSpecAnnotationKey keeps the spec an adopted object had before the converter took it over
+	previousSpecAnnotationKey = converterAnnotationPrefix + "previous-spec"
+)
+
+func (p AdoptionPolicy) validate() error {
+	switch p {
+	case "", AdoptionPolicyNever, AdoptionPolicyIfAnnotated, AdoptionPolicyAlways:
+		return nil
+	}
+	return fmt.Errorf("unknown adoption policy %q, must be one of: %q, %q, %q", p, AdoptionPolicyNever, AdoptionPolicyIfAnnotated, AdoptionPolicyAlways)
+}
+
+// canUpdate reports whether the converter may update the existing object,
+// objects created by somebody else are updated only if the adoption policy allows it
+func (c *ConverterController) canUpdate(existing metav1.Object) bool {
+	if isConverterManaged(existing) {
+		return true
+	}
+	switch c.config().AdoptionPolicy {
+	case AdoptionPolicyAlways:
+		return true
+	case AdoptionPolicyIfAnnotated:
+		return existing.GetAnnotations()[adoptAnnotationKey] == "true"
+	}
+	return false
+}
+
+// backupPreviousSpec keeps the spec of the existing object in the desired metadata when the object is adopted,
+// the backup made on adoption is carried over by later applies. It reports whether the object is being adopted.
+func backupPreviousSpec(desired *metav1.ObjectMeta, existing metav1.Object, existingSpec interface{}) (bool, error) {
+	if desired.Annotations == nil {
+		desired.Annotations = map[string]string{}
+	}
+	if isConverterManaged(existing) {
+		if backup, ok := existing.GetAnnotations()[previousSpecAnnotationKey]; ok {
+			desired.Annotations[previousSpecAnnotationKey] = backup
+		}
+		return false, nil
+	}
+
+	backup, err := json.Marshal(existingSpec)
+	if err != nil {
+		return false, fmt.Errorf("cannot marshal previous spec: %w", err)
+	}
+	desired.Annotations[previousSpecAnnotationKey] = string(backup)
+	if err = apivalidation.ValidateAnnotationsSize(desired.Annotations); err != nil {
+		delete(desired.Annotations, previousSpecAnnotationKey)
+		return false, fmt.Errorf("previous spec is too large to be backed up: %w", err)
+	}
+	return true, nil
+}
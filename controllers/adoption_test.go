@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAdoptionPolicyOfUnmanagedFolder(t *testing.T) {
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"},
+		Spec:       v1alpha1.GrafanaFolderSpec{FolderName: "converted"},
+	}
+	for _, tc := range []struct {
+		name      string
+		policy    AdoptionPolicy
+		annotated bool
+		adopted   bool
+	}{
+		{name: "default", policy: "", annotated: true, adopted: false},
+		{name: "never", policy: AdoptionPolicyNever, annotated: true, adopted: false},
+		{name: "ifAnnotated without annotation", policy: AdoptionPolicyIfAnnotated, annotated: false, adopted: false},
+		{name: "ifAnnotated", policy: AdoptionPolicyIfAnnotated, annotated: true, adopted: true},
+		{name: "always", policy: AdoptionPolicyAlways, annotated: false, adopted: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			existing := &v1beta1.GrafanaFolder{
+				ObjectMeta: metav1.ObjectMeta{Name: source.Name, Namespace: source.Namespace},
+				Spec:       v1beta1.GrafanaFolderSpec{Title: "hand-made"},
+			}
+			if tc.annotated {
+				existing.Annotations = map[string]string{adoptAnnotationKey: "true"}
+			}
+			client := newFakeV1beta1Clientset(existing)
+			controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
+			controller.setConfig(ConverterConfig{AdoptionPolicy: tc.policy})
+
+			err := controller.reconcileGrafanaFolder(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaFolderKind, source), false)
+			if tc.adopted {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, errNotManaged)
+			}
+
+			actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders(source.Namespace).Get(
+				context.Background(), source.Name, metav1.GetOptions{},
+			)
+			require.NoError(t, err)
+			if !tc.adopted {
+				assert.Equal(t, "hand-made", actual.Spec.Title)
+				assert.False(t, isConverterManaged(actual))
+				return
+			}
+			assert.Equal(t, "converted", actual.Spec.Title)
+			assert.True(t, isConverterManaged(actual))
+			assert.Contains(t, actual.Annotations[previousSpecAnnotationKey], `"title":"hand-made"`)
+		})
+	}
+}
+
+func TestAdoptedFolderKeepsPreviousSpecOnLaterApplies(t *testing.T) {
+	client := newFakeV1beta1Clientset(&v1beta1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"},
+		Spec:       v1beta1.GrafanaFolderSpec{Title: "hand-made"},
+	})
+	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
+	controller.setConfig(ConverterConfig{AdoptionPolicy: AdoptionPolicyAlways})
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"},
+		Spec:       v1alpha1.GrafanaFolderSpec{FolderName: "converted"},
+	}
+	require.NoError(t, controller.reconcileGrafanaFolder(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaFolderKind, source), false))
+
+	source.Spec.FolderName = "renamed"
+	require.NoError(t, controller.reconcileGrafanaFolder(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaFolderKind, source), false))
+
+	actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders("product-a").Get(
+		context.Background(), "sample-folder", metav1.GetOptions{},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", actual.Spec.Title)
+	assert.Contains(t, actual.Annotations[previousSpecAnnotationKey], `"title":"hand-made"`)
+}
+
+func TestReadConfigRejectsUnknownAdoptionPolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "parameters.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("enable: true\nadoptionPolicy: sometimes\n"), 0o600))
+
+	_, err := ReadConfig(path)
+
+	assert.ErrorContains(t, err, "adoptionPolicy")
+}
@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/sets"
+	metav1ac "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/client-go/util/csaupgrade"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+)
+
+// fieldManager owns fields of converted objects set by the converter with server-side apply
+const fieldManager = "grafana-operator-converter"
+
+// applyOptions take over fields which were changed by other managers,
+// the converter is the only source of truth for fields it sets
+var applyOptions = metav1.ApplyOptions{FieldManager: fieldManager, Force: true}
+
+// fieldOwnershipPatch moves fields owned by the managers which created and updated the object
+// before the converter switched to server-side apply to the converter's apply manager.
+// Those managers are named after the converter binary and are recognized by owning the converter's label,
+// without the move labels and annotations removed from the source are never dropped from the object.
+// It returns nil if there is nothing to move.
+func fieldOwnershipPatch(object interface {
+	runtime.Object
+	metav1.Object
+}) ([]byte, error) {
+	label := fieldpath.NewSet(fieldpath.MakePathOrDie("metadata", "labels", managedByOperatorLabelKey))
+	legacyManagers := sets.New[string]()
+	for _, owner := range csaupgrade.FindFieldsOwners(object.GetManagedFields(), metav1.ManagedFieldsOperationUpdate, label) {
+		if owner.Manager != fieldManager && owner.Subresource == "" {
+			legacyManagers.Insert(owner.Manager)
+		}
+	}
+	if legacyManagers.Len() == 0 {
+		return nil, nil
+	}
+	return csaupgrade.UpgradeManagedFieldsPatch(object, legacyManagers, fieldManager)
+}
+
+// objectMetaApplyConfiguration declares the metadata of a converted object,
+// labels and annotations absent here are removed from the object if the converter set them before
+func objectMetaApplyConfiguration(meta metav1.ObjectMeta) *metav1ac.ObjectMetaApplyConfiguration {
+	applyConfiguration := metav1ac.ObjectMeta().
+		WithName(meta.Name).
+		WithNamespace(meta.Namespace).
+		WithLabels(meta.Labels).
+		WithAnnotations(meta.Annotations)
+	for _, ref := range meta.OwnerReferences {
+		owner := metav1ac.OwnerReference().
+			WithAPIVersion(ref.APIVersion).
+			WithKind(ref.Kind).
+			WithName(ref.Name).
+			WithUID(ref.UID)
+		if ref.Controller != nil {
+			owner.WithController(*ref.Controller)
+		}
+		if ref.BlockOwnerDeletion != nil {
+			owner.WithBlockOwnerDeletion(*ref.BlockOwnerDeletion)
+		}
+		applyConfiguration.WithOwnerReferences(owner)
+	}
+	return applyConfiguration
+}
+
+// specApplyConfiguration fills the apply configuration of a converted spec,
+// generated apply configurations share the JSON representation with API types
+func specApplyConfiguration(spec, applyConfiguration interface{}) error {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, applyConfiguration)
+}
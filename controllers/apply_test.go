@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	v1beta1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/clientset/versioned/fake"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/managedfields"
+	"k8s.io/apimachinery/pkg/watch"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+// newFakeV1beta1Clientset returns a fake clientset which supports server-side apply of v1beta1 objects,
+// the generated one has no OpenAPI schema of custom resources, so their field sets are deduced
+func newFakeV1beta1Clientset(objects ...runtime.Object) *v1beta1fake.Clientset {
+	scheme := runtime.NewScheme()
+	if err := v1beta1fake.AddToScheme(scheme); err != nil {
+		panic(err)
+	}
+	tracker := clienttesting.NewFieldManagedObjectTracker(
+		scheme,
+		serializer.NewCodecFactory(scheme).UniversalDecoder(),
+		managedfields.NewDeducedTypeConverter(),
+	)
+	for _, obj := range objects {
+		if err := tracker.Add(obj); err != nil {
+			panic(err)
+		}
+	}
+
+	client := v1beta1fake.NewClientset()
+	client.PrependReactor("*", "*", clienttesting.ObjectReaction(tracker))
+	client.PrependWatchReactor("*", func(action clienttesting.Action) (bool, watch.Interface, error) {
+		w, err := tracker.Watch(action.GetResource(), action.GetNamespace())
+		return err == nil, w, err
+	})
+	return client
+}
+
+func TestApplyRemovesLabelsDroppedFromSource(t *testing.T) {
+	client := newFakeV1beta1Clientset()
+	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sample-folder",
+			Namespace: "product-a",
+			Labels:    map[string]string{"team": "a", "tier": "backend"},
+		},
+		Spec: v1alpha1.GrafanaFolderSpec{FolderName: "sample"},
+	}
+	require.NoError(t, controller.reconcileGrafanaFolder(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaFolderKind, source), false))
+
+	delete(source.Labels, "tier")
+	require.NoError(t, controller.reconcileGrafanaFolder(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaFolderKind, source), false))
+
+	actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders("product-a").Get(
+		context.Background(), "sample-folder", metav1.GetOptions{},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "a", actual.Labels["team"])
+	assert.NotContains(t, actual.Labels, "tier")
+	require.NotEmpty(t, actual.ManagedFields)
+	assert.Equal(t, fieldManager, actual.ManagedFields[0].Manager)
+}
+
+func TestApplyTakesOverFieldsEditedByOthers(t *testing.T) {
+	client := newFakeV1beta1Clientset()
+	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"},
+		Spec:       v1alpha1.GrafanaFolderSpec{FolderName: "converted"},
+	}
+	require.NoError(t, controller.reconcileGrafanaFolder(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaFolderKind, source), false))
+	folders := client.GrafanaIntegreatlyV1beta1().GrafanaFolders("product-a")
+	edited, err := folders.Get(context.Background(), "sample-folder", metav1.GetOptions{})
+	require.NoError(t, err)
+	edited.Spec.Title = "edited"
+	_, err = folders.Update(context.Background(), edited, metav1.UpdateOptions{FieldManager: "kubectl-edit"})
+	require.NoError(t, err)
+
+	// the field is taken back when the source changes next time
+	source.Generation++
+	require.NoError(t, controller.reconcileGrafanaFolder(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaFolderKind, source), false))
+
+	actual, err := folders.Get(context.Background(), "sample-folder", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "converted", actual.Spec.Title)
+}
+
+func TestApplyRemovesLabelsFromObjectUpdatedBeforeServerSideApply(t *testing.T) {
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sample-folder",
+			Namespace: "product-a",
+			Labels:    map[string]string{"team": "a", "tier": "backend"},
+		},
+		Spec: v1alpha1.GrafanaFolderSpec{FolderName: "sample"},
+	}
+	converted := newFakeV1beta1Clientset()
+	converter := &ConverterController{log: logr.Discard(), v1beta1clientset: converted}
+	require.NoError(t, converter.reconcileGrafanaFolder(context.Background(), logr.Discard(), source, converter.place(v1alpha1.GrafanaFolderKind, source), false))
+	legacy, err := converted.GrafanaIntegreatlyV1beta1().GrafanaFolders("product-a").Get(context.Background(), "sample-folder", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	// the converter created the object with the manager named after its binary
+	client := newFakeV1beta1Clientset()
+	folders := client.GrafanaIntegreatlyV1beta1().GrafanaFolders("product-a")
+	legacy.ManagedFields = nil
+	_, err = folders.Create(context.Background(), legacy, metav1.CreateOptions{FieldManager: "converter"})
+	require.NoError(t, err)
+	edited, err := folders.Get(context.Background(), "sample-folder", metav1.GetOptions{})
+	require.NoError(t, err)
+	edited.Spec.Title = "edited"
+	_, err = folders.Update(context.Background(), edited, metav1.UpdateOptions{FieldManager: "kubectl-edit"})
+	require.NoError(t, err)
+
+	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
+	delete(source.Labels, "tier")
+	source.Generation++
+	require.NoError(t, controller.reconcileGrafanaFolder(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaFolderKind, source), false))
+
+	actual, err := folders.Get(context.Background(), "sample-folder", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "a", actual.Labels["team"])
+	assert.NotContains(t, actual.Labels, "tier")
+	assert.Equal(t, "sample", actual.Spec.Title)
+	managers := make([]string, 0, len(actual.ManagedFields))
+	for _, entry := range actual.ManagedFields {
+		managers = append(managers, entry.Manager)
+	}
+	assert.Contains(t, managers, fieldManager)
+	assert.NotContains(t, managers, "converter")
+}
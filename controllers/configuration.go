@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+
+	converterinformers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/converter/informers/externalversions"
+	converterv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/converter/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/cache"
+)
+
+// watchConfiguration starts the informer of the ConverterConfiguration named default.
+// The resource is optional, without its CRD or permissions to read it the converter is configured by its file only.
+func (c *ConverterController) watchConfiguration(ctx context.Context) error {
+	if c.configurationClientset == nil {
+		return nil
+	}
+	configurations := c.configurationClientset.ConverterV1alpha1().ConverterConfigurations()
+	if _, err := configurations.List(ctx, metav1.ListOptions{Limit: 1}); err != nil {
+		if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+			c.log.Info("ConverterConfiguration resource is not available, the converter is configured by its configuration file only", "reason", err.Error())
+			return nil
+		}
+		return fmt.Errorf("cannot list converter configurations: %w", err)
+	}
+
+	factory := converterinformers.NewSharedInformerFactoryWithOptions(c.configurationClientset, 0,
+		converterinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", converterv1alpha1.ConverterConfigurationName).String()
+		}))
+	informer := factory.Converter().V1alpha1().ConverterConfigurations()
+	notify := func() {
+		select {
+		case c.configurationChanged <- struct{}{}:
+		default:
+			// a reload is already pending, it reads the latest spec
+		}
+	}
+	_, err := informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(interface{}) { notify() },
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldConfiguration, oldOk := oldObj.(*converterv1alpha1.ConverterConfiguration)
+			newConfiguration, newOk := newObj.(*converterv1alpha1.ConverterConfiguration)
+			// status updates written by the converter do not change the generation
+			if !oldOk || !newOk || oldConfiguration.Generation != newConfiguration.Generation {
+				notify()
+			}
+		},
+		DeleteFunc: func(interface{}) { notify() },
+	})
+	if err != nil {
+		return fmt.Errorf("cannot watch converter configurations: %w", err)
+	}
+	c.configurationLister = informer.Lister()
+
+	factory.Start(ctx.Done())
+	for _, ok := range factory.WaitForCacheSync(ctx.Done()) {
+		if !ok {
+			return fmt.Errorf("converter configurations informer cache is not synced")
+		}
+	}
+	return nil
+}
+
+// configuration returns the ConverterConfiguration named default or nil if it does not exist
+func (c *ConverterController) configuration() *converterv1alpha1.ConverterConfiguration {
+	if c.configurationLister == nil {
+		return nil
+	}
+	configuration, err := c.configurationLister.Get(converterv1alpha1.ConverterConfigurationName)
+	if err != nil {
+		return nil
+	}
+	return configuration
+}
+
+// loadConfig reads the configuration from the ConverterConfiguration if it exists or from the configuration file
+func (c *ConverterController) loadConfig(configuration *converterv1alpha1.ConverterConfiguration) (*ConverterConfig, error) {
+	if configuration == nil {
+		return ReadConfig(c.configPath)
+	}
+	data, err := json.Marshal(configuration.Spec)
+	if err != nil {
+		return &ConverterConfig{}, fmt.Errorf("cannot encode ConverterConfiguration spec: %w", err)
+	}
+	// the spec mirrors the configuration file, so it is validated the same way
+	return ParseConfig(data)
+}
+
+// updateConfigurationStatus reports the configuration the converter runs with in the status of the ConverterConfiguration,
+// loadErr is the error of the spec which could not be loaded
+func (c *ConverterController) updateConfigurationStatus(ctx context.Context, configuration *converterv1alpha1.ConverterConfiguration, conf ConverterConfig, loadErr error) {
+	c.statusMu.Lock()
+	defer c.statusMu.Unlock()
+	c.lastLoadErr = loadErr
+	c.writeConfigurationStatus(ctx, configuration, conf, loadErr)
+}
+
+// refreshConfigurationStatus reports the configuration again after overrides of a namespace changed,
+// the result of the last load of the spec is kept
+func (c *ConverterController) refreshConfigurationStatus(ctx context.Context) {
+	c.statusMu.Lock()
+	defer c.statusMu.Unlock()
+	c.writeConfigurationStatus(ctx, c.configuration(), c.config(), c.lastLoadErr)
+}
+
+// writeConfigurationStatus updates the status of the ConverterConfiguration if it changed, the caller holds statusMu
+func (c *ConverterController) writeConfigurationStatus(ctx context.Context, configuration *converterv1alpha1.ConverterConfiguration, conf ConverterConfig, loadErr error) {
+	if configuration == nil {
+		return
+	}
+	status := configuration.Status.DeepCopy()
+	status.ObservedGeneration = configuration.Generation
+	status.ActiveConverters = c.activeConverters(conf)
+	status.WatchedNamespaces = c.watchedNamespaces()
+	if slices.Equal(status.WatchedNamespaces, []string{metav1.NamespaceAll}) {
+		status.WatchedNamespaces = nil
+	}
+	status.Namespaces = c.namespaceStatuses()
+
+	loaded := metav1.Condition{
+		Type:               converterv1alpha1.ConditionLoaded,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Loaded",
+		Message:            "the spec is loaded by the converter",
+		ObservedGeneration: configuration.Generation,
+	}
+	if loadErr != nil {
+		status.LastLoadError = loadErr.Error()
+		loaded.Status = metav1.ConditionFalse
+		loaded.Reason = "InvalidSpec"
+		loaded.Message = fmt.Sprintf("the converter keeps its previous configuration: %s", loadErr)
+	}
+	meta.SetStatusCondition(&status.Conditions, loaded)
+
+	active := metav1.Condition{
+		Type:               converterv1alpha1.ConditionActive,
+		Status:             metav1.ConditionTrue,
+		Reason:             "Converting",
+		Message:            fmt.Sprintf("the converter converts %v", status.ActiveConverters),
+		ObservedGeneration: configuration.Generation,
+	}
+	if len(status.ActiveConverters) == 0 {
+		active.Status = metav1.ConditionFalse
+		active.Reason = "Disabled"
+		active.Message = "the converter does not convert any kind"
+	}
+	meta.SetStatusCondition(&status.Conditions, active)
+
+	if reflect.DeepEqual(*status, configuration.Status) {
+		return
+	}
+	updated := configuration.DeepCopy()
+	updated.Status = *status
+	if _, err := c.configurationClientset.ConverterV1alpha1().ConverterConfigurations().UpdateStatus(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		c.log.Error(err, "cannot update ConverterConfiguration status", "name", configuration.Name)
+	}
+}
+
+// activeConverters returns kinds of v1alpha1 objects converted with the configuration
+func (c *ConverterController) activeConverters(conf ConverterConfig) []string {
+	if !conf.Enable {
+		return nil
+	}
+	var kinds []string
+	for _, kind := range c.convertedKinds(conf) {
+		if kind.enabled {
+			kinds = append(kinds, kind.kind)
+		}
+	}
+	return kinds
+}
@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	converterfake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/converter/clientset/versioned/fake"
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	converterv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/converter/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func startController(t *testing.T, controller *ConverterController) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		assert.NoError(t, controller.Start(ctx))
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-stopped
+	})
+}
+
+func TestConfigurationTakesPrecedenceOverFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "parameters.yaml")
+	writeConfig(t, path, "enable: true\nfolder: true\n")
+	configurationClient := converterfake.NewSimpleClientset(&converterv1alpha1.ConverterConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: converterv1alpha1.ConverterConfigurationName, Generation: 1},
+		Spec: converterv1alpha1.ConverterConfigurationSpec{
+			Enable:            true,
+			Strategy:          string(SyncStrategySync),
+			EnabledConverters: converterv1alpha1.EnabledConverters{Dashboard: true},
+		},
+	})
+	controller, err := NewGrafanaConverterController(context.Background(), path, v1alpha1fake.NewSimpleClientset(), newFakeV1beta1Clientset(), configurationClient, nil, nil, 0, logr.Discard())
+	require.NoError(t, err)
+
+	startController(t, controller)
+
+	require.Eventually(t, func() bool { return controller.queue(v1alpha1.GrafanaDashboardKind) != nil }, 5*time.Second, 10*time.Millisecond)
+	assert.Nil(t, controller.queue(v1alpha1.GrafanaFolderKind), "the configuration file is ignored while the ConverterConfiguration exists")
+	configuration, err := configurationClient.ConverterV1alpha1().ConverterConfigurations().Get(context.Background(), converterv1alpha1.ConverterConfigurationName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), configuration.Status.ObservedGeneration)
+	assert.Equal(t, []string{v1alpha1.GrafanaDashboardKind}, configuration.Status.ActiveConverters)
+	assert.True(t, meta.IsStatusConditionTrue(configuration.Status.Conditions, converterv1alpha1.ConditionLoaded))
+	assert.True(t, meta.IsStatusConditionTrue(configuration.Status.Conditions, converterv1alpha1.ConditionActive))
+
+	// the configuration file is the fallback when the resource is deleted
+	require.NoError(t, configurationClient.ConverterV1alpha1().ConverterConfigurations().Delete(context.Background(), converterv1alpha1.ConverterConfigurationName, metav1.DeleteOptions{}))
+	assert.Eventually(t, func() bool {
+		return controller.queue(v1alpha1.GrafanaFolderKind) != nil && controller.queue(v1alpha1.GrafanaDashboardKind) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestInvalidConfigurationKeepsCurrentOne(t *testing.T) {
+	configuration := &converterv1alpha1.ConverterConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: converterv1alpha1.ConverterConfigurationName, Generation: 1},
+		Spec: converterv1alpha1.ConverterConfigurationSpec{
+			Enable:            true,
+			EnabledConverters: converterv1alpha1.EnabledConverters{Folder: true},
+		},
+	}
+	configurationClient := converterfake.NewSimpleClientset(configuration)
+	controller, err := NewGrafanaConverterController(context.Background(), "", v1alpha1fake.NewSimpleClientset(), newFakeV1beta1Clientset(), configurationClient, nil, nil, 0, logr.Discard())
+	require.NoError(t, err)
+	startController(t, controller)
+	require.Eventually(t, func() bool { return controller.queue(v1alpha1.GrafanaFolderKind) != nil }, 5*time.Second, 10*time.Millisecond)
+
+	invalid := configuration.DeepCopy()
+	invalid.Generation = 2
+	invalid.Spec.InstanceSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+		{Key: "app", Operator: "Equals", Values: []string{"grafana"}},
+	}}
+	_, err = configurationClient.ConverterV1alpha1().ConverterConfigurations().Update(context.Background(), invalid, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		current, err := configurationClient.ConverterV1alpha1().ConverterConfigurations().Get(context.Background(), configuration.Name, metav1.GetOptions{})
+		return err == nil && current.Status.ObservedGeneration == 2 &&
+			meta.IsStatusConditionFalse(current.Status.Conditions, converterv1alpha1.ConditionLoaded)
+	}, 5*time.Second, 10*time.Millisecond)
+	current, err := configurationClient.ConverterV1alpha1().ConverterConfigurations().Get(context.Background(), configuration.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Contains(t, current.Status.LastLoadError, "instanceSelector:")
+	assert.Equal(t, []string{v1alpha1.GrafanaFolderKind}, current.Status.ActiveConverters)
+	assert.Nil(t, controller.config().InstanceSelector)
+}
+
+func TestWatchConfigurationWithoutCRD(t *testing.T) {
+	configurationClient := converterfake.NewSimpleClientset()
+	configurationClient.PrependReactor("list", "converterconfigurations", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(schema.GroupResource{Group: converterv1alpha1.GroupVersion.Group, Resource: "converterconfigurations"}, "")
+	})
+	controller, err := NewGrafanaConverterController(context.Background(), "", v1alpha1fake.NewSimpleClientset(), newFakeV1beta1Clientset(), configurationClient, nil, nil, 0, logr.Discard())
+	require.NoError(t, err)
+
+	require.NoError(t, controller.watchConfiguration(context.Background()))
+	assert.Nil(t, controller.configuration())
+}
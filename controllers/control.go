@@ -0,0 +1,181 @@
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Annotations of v1alpha1 objects which control the conversion of one object. They carry the converter prefix,
+// so they are never copied to converted objects.
+const (
+	// skipAnnotationKey set to "true" excludes the object from the conversion,
+	// objects converted from it earlier are handled like objects of a deleted source
+	skipAnnotationKey = converterAnnotationPrefix + "skip"
+	// pausedAnnotationKey set to "true" stops syncing the object, its converted objects are left as they are
+	pausedAnnotationKey = converterAnnotationPrefix + "paused"
+	// targetNameAnnotationKey sets the name of the converted object,
+	// for GrafanaDataSource it replaces the namespace prefix of names of converted datasources
+	targetNameAnnotationKey = converterAnnotationPrefix + "target-name"
+	// targetNamespaceAnnotationKey sets the namespace of converted objects
+	targetNamespaceAnnotationKey = converterAnnotationPrefix + "target-namespace"
+	// instanceSelectorAnnotationKey overrides the instance selector of converted objects with a label selector, e.g. "app=grafana"
+	instanceSelectorAnnotationKey = converterAnnotationPrefix + "instance-selector"
+	// reconcileNonceAnnotationKey forces the conversion when its value changes, even if the source is up to date
+	reconcileNonceAnnotationKey = converterAnnotationPrefix + "reconcile-nonce"
+)
+
+// objectControl is the conversion control of one v1alpha1 object read from its annotations
+type objectControl struct {
+	skip             bool
+	paused           bool
+	instanceSelector *metav1.LabelSelector
+}
+
+// controlOf reads and validates control annotations of the v1alpha1 object
+func controlOf(source metav1.Object) (objectControl, error) {
+	var (
+		control objectControl
+		errs    []error
+	)
+	annotations := source.GetAnnotations()
+	for key, value := range map[string]*bool{skipAnnotationKey: &control.skip, pausedAnnotationKey: &control.paused} {
+		raw, ok := annotations[key]
+		if !ok {
+			continue
+		}
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("annotation %s: must be true or false, got %q", key, raw))
+		}
+		*value = parsed
+	}
+	if name, ok := annotations[targetNameAnnotationKey]; ok {
+		for _, msg := range validation.IsDNS1123Subdomain(name) {
+			errs = append(errs, fmt.Errorf("annotation %s: %s", targetNameAnnotationKey, msg))
+		}
+	}
+	if namespace, ok := annotations[targetNamespaceAnnotationKey]; ok {
+		for _, msg := range validation.IsDNS1123Label(namespace) {
+			errs = append(errs, fmt.Errorf("annotation %s: %s", targetNamespaceAnnotationKey, msg))
+		}
+	}
+	if selector, ok := annotations[instanceSelectorAnnotationKey]; ok {
+		var err error
+		if control.instanceSelector, err = metav1.ParseToLabelSelector(selector); err != nil {
+			errs = append(errs, fmt.Errorf("annotation %s: %w", instanceSelectorAnnotationKey, err))
+		}
+	}
+	// errors are sorted, because annotations are read from a map
+	slices.SortFunc(errs, func(a, b error) int { return strings.Compare(a.Error(), b.Error()) })
+	return control, errors.Join(errs...)
+}
+
+// placement describes where objects converted from a v1alpha1 object go. It is resolved once per sync
+// of the object, so all its converted objects are placed with one snapshot of the configuration.
+type placement struct {
+	// conf is the configuration the object is converted with, its instance selector is the one of the object
+	conf ConverterConfig
+	// namespace and prefix are the namespace and the name prefix of converted objects,
+	// objects which can not be placed keep the namespace of their source and get no prefix
+	namespace string
+	prefix    string
+	// err is the error of routing and placing the object, it is not converted until it is nil
+	err error
+}
+
+// place resolves the placement of objects converted from the v1alpha1 object of the kind
+func (c *ConverterController) place(kind string, source metav1.Object) placement {
+	p := placement{conf: c.configFor(source.GetNamespace())}
+	p.namespace = targetNamespaceOf(p.conf, source)
+	selector, err := c.instanceSelector(p.conf, kind, source)
+	if err != nil {
+		p.err = fmt.Errorf("cannot select Grafana instances of %s: %w", kind, err)
+		return p
+	}
+	p.conf.InstanceSelector = selector
+	namespace, err := c.placedNamespace(p.conf, kind, source)
+	if err == nil {
+		p.namespace = namespace
+		p.prefix, err = namePrefix(p.conf, kind, source, namespace)
+	}
+	switch {
+	case errors.Is(err, errUnplaceable):
+		// changes of Grafanas and of the configuration convert the object again
+		p.err = permanent(fmt.Errorf("cannot place %s: %w", kind, err))
+	case err != nil:
+		p.err = fmt.Errorf("cannot place %s: %w", kind, err)
+	default:
+		// converted objects outside of watched namespaces are watched once objects are placed there
+		c.watchTargetNamespace(p.namespace)
+	}
+	return p
+}
+
+// targetName returns the name of the object converted from the v1alpha1 object, name is the one
+// the converter uses without the target name annotation and the name prefix
+func (p placement) targetName(source metav1.Object, name string) string {
+	if target := source.GetAnnotations()[targetNameAnnotationKey]; target != "" {
+		return target
+	}
+	return p.prefix + name
+}
+
+// instanceSelector returns the instance selector of objects converted from the v1alpha1 object of the kind.
+// The instance selector annotation of the object takes precedence over the first matching instance selector rule,
+// which takes precedence over legacy Grafanas importing dashboards and then over the instance selector of the namespace.
+func (c *ConverterController) instanceSelector(conf ConverterConfig, kind string, source metav1.Object) (*metav1.LabelSelector, error) {
+	if control, err := controlOf(source); err == nil && control.instanceSelector != nil {
+		return control.instanceSelector, nil
+	}
+	if selector := routedInstanceSelector(conf.InstanceSelectorRules, kind, source); selector != nil {
+		return selector, nil
+	}
+	if conf.InstanceSelectorMode == InstanceSelectorModeLegacyGrafana {
+		selector, err := c.legacyInstanceSelector(source)
+		if err != nil || selector != nil {
+			return selector, err
+		}
+	}
+	return conf.InstanceSelector, nil
+}
+
+// targetNamespaceOf returns the namespace the target namespace annotation of the v1alpha1 object sets
+// if the configuration allows it, or the namespace of the object
+func targetNamespaceOf(conf ConverterConfig, source metav1.Object) string {
+	if namespace := source.GetAnnotations()[targetNamespaceAnnotationKey]; namespace != "" && allowedTargetNamespace(conf, source, namespace) {
+		return namespace
+	}
+	return source.GetNamespace()
+}
+
+// deleteRetargetedObjects handles converted objects recorded on the source which it no longer produces as names
+// in the namespace, e.g. after its target name or namespace changed, like objects of a deleted source
+func (c *ConverterController) deleteRetargetedObjects(ctx context.Context, l logr.Logger, source metav1.Object, kind string, policy DeletionPolicy, namespace string, names []string,
+	deleteConverted func(ctx context.Context, l logr.Logger, namespace, name string) error) error {
+	var previous []convertedObjectReference
+	recorded, ok := source.GetAnnotations()[convertedObjectsAnnotationKey]
+	if !ok || json.Unmarshal([]byte(recorded), &previous) != nil {
+		return nil
+	}
+	var errs error
+	for _, ref := range previous {
+		if ref.Kind != kind || ref.Namespace == "" || ref.Namespace == namespace && slices.Contains(names, ref.Name) {
+			continue
+		}
+		if policy := c.convertedDeletionPolicy(policy, source, ref.Namespace); policy != DeletionPolicyDelete {
+			l.Info(fmt.Sprintf("%s %v/%v is no longer converted from its source, it is left to %q deletion policy", kind, ref.Namespace, ref.Name, policy))
+			continue
+		}
+		errs = errors.Join(errs, deleteConverted(ctx, l, ref.Namespace, ref.Name))
+	}
+	return errs
+}
@@ -0,0 +1,99 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	v1alpha1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestControlOfRejectsInvalidAnnotations(t *testing.T) {
+	_, err := controlOf(&metav1.ObjectMeta{Annotations: map[string]string{
+		skipAnnotationKey:             "yes",
+		targetNamespaceAnnotationKey:  "Product_B",
+		instanceSelectorAnnotationKey: "app in grafana",
+	}})
+
+	assert.ErrorContains(t, err, skipAnnotationKey)
+	assert.ErrorContains(t, err, targetNamespaceAnnotationKey)
+	assert.ErrorContains(t, err, instanceSelectorAnnotationKey)
+}
+
+func TestConvertGrafanaFolderHonoursControlAnnotations(t *testing.T) {
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a", UID: "source-uid", Annotations: map[string]string{
+			targetNameAnnotationKey:       "team-folder",
+			targetNamespaceAnnotationKey:  "monitoring",
+			instanceSelectorAnnotationKey: "app=grafana-b",
+			reconcileNonceAnnotationKey:   "1",
+		}},
+	}
+	controller := &ConverterController{log: logr.Discard()}
+	controller.setConfig(ConverterConfig{
+		InstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "grafana"}},
+		DeletionPolicy:   DeletionPolicies{Folder: DeletionPolicyOwnerReference},
+		TargetNamespace:  TargetNamespace{AllowedNamespaces: []string{"monitoring"}},
+	})
+
+	converted := controller.convertGrafanaFolder(source, controller.place(v1alpha1.GrafanaFolderKind, source))
+
+	assert.Equal(t, "monitoring", converted.Namespace)
+	assert.Equal(t, "team-folder", converted.Name)
+	assert.Equal(t, map[string]string{"app": "grafana-b"}, converted.Spec.InstanceSelector.MatchLabels)
+	assert.Empty(t, converted.OwnerReferences, "owner references can not point to other namespaces")
+	assert.Equal(t, "product-a", converted.Annotations[sourceNamespaceAnnotationKey])
+	for _, key := range []string{targetNameAnnotationKey, targetNamespaceAnnotationKey, instanceSelectorAnnotationKey, reconcileNonceAnnotationKey} {
+		assert.NotContains(t, converted.Annotations, key)
+	}
+
+	source.Annotations[reconcileNonceAnnotationKey] = "2"
+	reconverted := controller.convertGrafanaFolder(source, controller.place(v1alpha1.GrafanaFolderKind, source))
+	assert.NotEqual(t, converted.Annotations[conversionHashAnnotationKey], reconverted.Annotations[conversionHashAnnotationKey],
+		"a new nonce forces the conversion")
+}
+
+func TestSyncGrafanaFolderHonoursSkipAndPause(t *testing.T) {
+	existing := &v1beta1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sample-folder",
+			Namespace: "product-a",
+			Labels:    map[string]string{converterManagedLabel: converterManagedValue},
+		},
+		Spec: v1beta1.GrafanaFolderSpec{Title: "Previous"},
+	}
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a", Annotations: map[string]string{pausedAnnotationKey: "true"}},
+		Spec:       v1alpha1.GrafanaFolderSpec{FolderName: "Current"},
+	}
+	client := newFakeV1beta1Clientset(existing)
+	informerFactory := v1alpha1informers.NewSharedInformerFactory(v1alpha1fake.NewSimpleClientset(), 0)
+	store := informerFactory.Integreatly().V1alpha1().GrafanaFolders().Informer().GetStore()
+	require.NoError(t, store.Add(source))
+	controller := &ConverterController{
+		log:                     logr.Discard(),
+		v1alpha1clientset:       v1alpha1fake.NewSimpleClientset(source),
+		v1beta1clientset:        client,
+		v1alpha1InformerFactory: []v1alpha1informers.SharedInformerFactory{informerFactory},
+	}
+	controller.setConfig(ConverterConfig{DeletionPolicy: DeletionPolicies{Folder: DeletionPolicyDelete}})
+
+	require.NoError(t, controller.syncGrafanaFolder(context.Background(), "product-a/sample-folder"))
+	folder, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders("product-a").Get(context.Background(), "sample-folder", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "Previous", folder.Spec.Title, "paused objects are not synced")
+
+	skipped := source.DeepCopy()
+	skipped.Annotations = map[string]string{skipAnnotationKey: "true"}
+	require.NoError(t, store.Update(skipped))
+	require.NoError(t, controller.syncGrafanaFolder(context.Background(), "product-a/sample-folder"))
+	_, err = client.GrafanaIntegreatlyV1beta1().GrafanaFolders("product-a").Get(context.Background(), "sample-folder", metav1.GetOptions{})
+	assert.True(t, apierrs.IsNotFound(err), "objects converted from skipped sources follow the deletion policy")
+}
@@ -2,16 +2,19 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"maps"
+	"time"
 
+	v1beta1ac "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/applyconfiguration/operator/v1beta1"
 	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
 	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
 	"github.com/go-logr/logr"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/utils/ptr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
@@ -19,118 +22,320 @@ const (
 	// newDatasourceUID = "$datasource"
 )
 
-// createGrafanaDashboard converts GrafanaDashboard v1alpha1 to v1beta1
-func (c *ConverterController) createGrafanaDashboard(dashboard interface{}) {
-	alphaDashboard, ok := dashboard.(*v1alpha1.GrafanaDashboard)
-	if !ok {
-		c.log.Error(fmt.Errorf("type assertion failed"), "cannot cast to v1alpha1 GrafanaDashboard")
-		return
+// getGrafanaDashboard returns GrafanaDashboard v1alpha1 from informer caches
+func (c *ConverterController) getGrafanaDashboard(namespace, name string) (*v1alpha1.GrafanaDashboard, error) {
+	for _, informerFactory := range c.sourceInformers() {
+		dashboard, err := informerFactory.Integreatly().V1alpha1().GrafanaDashboards().Lister().GrafanaDashboards(namespace).Get(name)
+		if err == nil || !apierrs.IsNotFound(err) {
+			return dashboard, err
+		}
 	}
-	l := c.log.WithValues("kind", v1alpha1.GrafanaDashboardKind, "name", alphaDashboard.Name, "ns", alphaDashboard.Namespace)
+	return nil, apierrs.NewNotFound(v1alpha1.GroupVersion.WithResource("grafanadashboards").GroupResource(), name)
+}
 
-	cr := c.convertGrafanaDashboard(alphaDashboard)
+// cachedGrafanaDashboard returns GrafanaDashboard v1beta1 from informer caches of converted objects,
+// nil if converted objects of its namespace are not watched or it is not cached
+func (c *ConverterController) cachedGrafanaDashboard(namespace, name string) *v1beta1.GrafanaDashboard {
+	for _, informerFactory := range c.convertedInformers() {
+		if dashboard, err := informerFactory.Observability().V1beta1().GrafanaDashboards().Lister().GrafanaDashboards(namespace).Get(name); err == nil {
+			return dashboard
+		}
+	}
+	return nil
+}
 
-	l.Info("start creating GrafanaDashboard")
-	betaDashboard, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDashboards(cr.Namespace).Create(context.Background(), cr, metav1.CreateOptions{})
+// syncGrafanaDashboard converts GrafanaDashboard v1alpha1 with the key to v1beta1
+// or propagates its deletion if it no longer exists
+func (c *ConverterController) syncGrafanaDashboard(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
-		if apierrs.IsAlreadyExists(err) {
-			c.updateGrafanaDashboard(nil, cr)
-			return
+		c.log.Error(err, "cannot parse GrafanaDashboard key", "key", key)
+		return nil
+	}
+	l := c.log.WithValues("kind", v1alpha1.GrafanaDashboardKind, "name", name, "ns", namespace)
+
+	start := time.Now()
+	alphaDashboard, err := c.getGrafanaDashboard(namespace, name)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			if c.queue(v1alpha1.GrafanaDashboardKind).isDriftOnly(key) {
+				// deletion of the source is handled when its own event is processed
+				return nil
+			}
+			if deselected, err := c.deselectedBySourceSelector(ctx, v1alpha1.GrafanaDashboardKind, namespace, name); err != nil || deselected {
+				// the source stopped matching the source selector, objects converted from it are left as they are
+				return err
+			}
+			// the last known state is needed to find the object converted into another namespace or with another name
+			lastState, _ := c.queue(v1alpha1.GrafanaDashboardKind).lastState(key)
+			deleted, ok := lastState.(*v1alpha1.GrafanaDashboard)
+			if !ok {
+				deleted = &v1alpha1.GrafanaDashboard{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+			}
+			err = c.deleteGrafanaDashboard(ctx, l, deleted, c.place(v1alpha1.GrafanaDashboardKind, deleted))
+			observeConversion(v1alpha1.GrafanaDashboardKind, operationDelete, start, err)
+			return err
 		}
-		l.Error(err, "cannot create GrafanaDashboard v1beta1 from v1alpha1")
-		return
+		return err
+	}
+	passes, err := c.passesSourceFilter(v1alpha1.GrafanaDashboardKind, alphaDashboard)
+	if err != nil {
+		return permanent(fmt.Errorf("cannot evaluate source filter of GrafanaDashboard: %w", err))
 	}
-	l.Info(fmt.Sprintf("GrafanaDashboard %v/%v uid:%v has been created",
-		betaDashboard.GetNamespace(),
-		betaDashboard.GetName(),
-		betaDashboard.GetUID()))
+	if !passes {
+		l.Info("GrafanaDashboard does not pass the source filter, it is not converted")
+		return nil
+	}
+	p := c.place(v1alpha1.GrafanaDashboardKind, alphaDashboard)
+	control, err := controlOf(alphaDashboard)
+	switch {
+	case err != nil:
+		err = permanent(fmt.Errorf("invalid control annotations of GrafanaDashboard: %w", err))
+	case control.paused:
+		l.Info("GrafanaDashboard is paused by annotation, it is not synced")
+		return nil
+	case control.skip:
+		l.Info("GrafanaDashboard is skipped by annotation, it is not converted")
+		err = c.deleteGrafanaDashboard(ctx, l, alphaDashboard, p)
+		observeConversion(v1alpha1.GrafanaDashboardKind, operationDelete, start, err)
+		return err
+	default:
+		err = c.reconcileGrafanaDashboard(ctx, l, alphaDashboard, p, c.reportsDrift(v1alpha1.GrafanaDashboardKind, key))
+	}
+	c.recordConversionFailed(alphaDashboard, "GrafanaDashboard", p.namespace, []string{p.targetName(alphaDashboard, name)}, err)
+	err = c.updateGrafanaDashboardStatus(ctx, alphaDashboard, p, err)
+	if err == nil {
+		err = c.recordGrafanaDashboardReferences(ctx, l, alphaDashboard, p)
+	}
+	observeConversion(v1alpha1.GrafanaDashboardKind, operationConvert, start, err)
+	return err
 }
 
-// updateGrafanaDashboard converts GrafanaDashboard v1alpha1 to v1beta1
-func (c *ConverterController) updateGrafanaDashboard(old, new interface{}) {
-	var v1beta1Dashboard *v1beta1.GrafanaDashboard
-	var l logr.Logger
-	dashboard, ok := new.(*v1alpha1.GrafanaDashboard)
-	if ok && old != nil {
-		l = c.log.WithValues("kind", v1alpha1.GrafanaDashboardKind, "name", dashboard.Name, "ns", dashboard.Namespace)
+// reconcileGrafanaDashboard creates or updates GrafanaDashboard v1beta1 converted from GrafanaDashboard v1alpha1,
+// with reportOnly set it only reports the drift of the converted object
+func (c *ConverterController) reconcileGrafanaDashboard(ctx context.Context, l logr.Logger, alphaDashboard *v1alpha1.GrafanaDashboard, p placement, reportOnly bool) error {
+	l.Info(fmt.Sprintf("start converting GrafanaDashboard %s to %s", v1alpha1.GroupVersion.String(), v1beta1.GroupVersion.String()))
+	if p.err != nil {
+		return p.err
+	}
+	// large dashboards are not converted again while the cached converted object is up to date
+	meta := c.grafanaDashboardObjectMeta(alphaDashboard, p)
+	if cached := c.cachedGrafanaDashboard(meta.Namespace, meta.Name); cached != nil && c.cachedUpToDate(v1alpha1.GrafanaDashboardKind, cached, &meta, p, reportOnly) {
+		l.Info(fmt.Sprintf("GrafanaDashboard %v/%v is up to date with its source, it is not applied again", cached.Namespace, cached.Name))
+		return nil
+	}
+	v1beta1Dashboard := c.convertGrafanaDashboard(alphaDashboard, p)
 
-		var alphaDashboardOld *v1alpha1.GrafanaDashboard
-		alphaDashboardOld, ok = old.(*v1alpha1.GrafanaDashboard)
-		if !ok {
-			l.Error(fmt.Errorf("type assertion failed"), "cannot cast to v1alpha1 GrafanaDashboard")
-			return
+	var previous *v1beta1.GrafanaDashboard
+	existingDashboard, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDashboards(v1beta1Dashboard.Namespace).Get(ctx, v1beta1Dashboard.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrs.IsNotFound(err) {
+			return fmt.Errorf("cannot get existing GrafanaDashboard: %w", err)
 		}
-
-		if apiequality.Semantic.DeepEqual(alphaDashboardOld.Spec, dashboard.Spec) {
-			l.Info("no diffs in GrafanaDashboards")
-			return
+		if reportOnly {
+			l.Info(fmt.Sprintf("GrafanaDashboard %v/%v has been deleted, the drift is reported and not restored", v1beta1Dashboard.Namespace, v1beta1Dashboard.Name))
+			return nil
 		}
-		l.Info(fmt.Sprintf("start converting GrafanaDashboard %s to %s", v1alpha1.GroupVersion.String(), v1beta1.GroupVersion.String()))
-		v1beta1Dashboard = c.convertGrafanaDashboard(dashboard)
 	} else {
-		v1beta1Dashboard, ok = new.(*v1beta1.GrafanaDashboard)
-		if !ok {
-			l.Error(fmt.Errorf("type assertion failed"), "cannot cast to v1beta1 GrafanaDashboard")
-			return
+		previous = existingDashboard
+		if !c.canUpdate(existingDashboard) {
+			c.recordNotManaged(alphaDashboard, existingDashboard, "GrafanaDashboard", existingDashboard.Namespace, existingDashboard.Name)
+			return permanent(fmt.Errorf("cannot update existing GrafanaDashboard: %w", errNotManaged))
+		}
+		if !p.conf.Strategy.updatesExisting() {
+			l.Info(fmt.Sprintf("GrafanaDashboard %v/%v already exists and is not updated with %q strategy", existingDashboard.Namespace, existingDashboard.Name, SyncStrategyCreateOnly))
+			return nil
+		}
+		if reportOnly {
+			if !apiequality.Semantic.DeepEqual(existingDashboard.Spec, v1beta1Dashboard.Spec) || c.metadataDrifted(existingDashboard, v1beta1Dashboard) {
+				l.Info(fmt.Sprintf("GrafanaDashboard %v/%v has drifted from the converted state, the drift is reported and not restored", existingDashboard.Namespace, existingDashboard.Name))
+			}
+			return nil
+		}
+		if c.isUpToDate(v1alpha1.GrafanaDashboardKind, existingDashboard, v1beta1Dashboard) {
+			l.Info(fmt.Sprintf("GrafanaDashboard %v/%v is up to date with its source, it is not applied again", existingDashboard.Namespace, existingDashboard.Name))
+			return nil
+		}
+		adopted, err := backupPreviousSpec(&v1beta1Dashboard.ObjectMeta, existingDashboard, existingDashboard.Spec)
+		if err != nil {
+			return permanent(fmt.Errorf("cannot adopt existing GrafanaDashboard: %w", err))
+		}
+		if adopted {
+			l.Info(fmt.Sprintf("GrafanaDashboard %v/%v is adopted by the converter, its previous spec is kept in %s annotation", existingDashboard.Namespace, existingDashboard.Name, previousSpecAnnotationKey))
+		}
+		ownershipPatch, err := fieldOwnershipPatch(existingDashboard)
+		if err != nil {
+			return permanent(fmt.Errorf("cannot migrate field ownership of existing GrafanaDashboard: %w", err))
+		}
+		if ownershipPatch != nil {
+			if _, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDashboards(existingDashboard.Namespace).Patch(ctx, existingDashboard.Name, types.JSONPatchType, ownershipPatch, metav1.PatchOptions{}); err != nil {
+				return fmt.Errorf("cannot migrate field ownership of existing GrafanaDashboard: %w", err)
+			}
+			l.Info(fmt.Sprintf("GrafanaDashboard %v/%v fields updated before server-side apply are now owned by %s", existingDashboard.Namespace, existingDashboard.Name, fieldManager))
+		}
+		if instanceSelectorChanged(existingDashboard.Spec.InstanceSelector, v1beta1Dashboard.Spec.InstanceSelector) {
+			client := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDashboards(existingDashboard.Namespace)
+			err = recreateConverted(ctx, l, "GrafanaDashboard", existingDashboard,
+				func(ctx context.Context, options metav1.DeleteOptions) error {
+					return client.Delete(ctx, existingDashboard.Name, options)
+				},
+				func(ctx context.Context) error {
+					_, err := client.Get(ctx, existingDashboard.Name, metav1.GetOptions{})
+					return err
+				})
+			if err != nil {
+				return err
+			}
 		}
-		l = c.log.WithValues("kind", v1alpha1.GrafanaDashboardKind, "name", v1beta1Dashboard.Name, "ns", v1beta1Dashboard.Namespace)
 	}
 
-	ctx := context.Background()
-	existingDashboard, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDashboards(v1beta1Dashboard.Namespace).Get(ctx, v1beta1Dashboard.Name, metav1.GetOptions{})
+	applyConfiguration, err := grafanaDashboardApplyConfiguration(v1beta1Dashboard)
+	if err != nil {
+		return permanent(fmt.Errorf("cannot build apply configuration of GrafanaDashboard: %w", err))
+	}
+	appliedDashboard, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDashboards(v1beta1Dashboard.Namespace).Apply(ctx, applyConfiguration, applyOptions)
+	if err != nil {
+		return fmt.Errorf("cannot apply GrafanaDashboard: %w", err)
+	}
+	l.Info(fmt.Sprintf("GrafanaDashboard %v/%v uid:%v has been applied",
+		appliedDashboard.GetNamespace(),
+		appliedDashboard.GetName(),
+		appliedDashboard.GetUID()))
+	// applies which change nothing keep the resource version and are not recorded as conversions
+	if previous == nil || appliedDashboard.ResourceVersion != previous.ResourceVersion {
+		c.recordConverted(alphaDashboard, appliedDashboard, "GrafanaDashboard", appliedDashboard.Namespace, appliedDashboard.Name, droppedGrafanaDashboardFields(alphaDashboard))
+	}
+	return nil
+}
+
+// updateGrafanaDashboardStatus writes the result of the conversion to the status of GrafanaDashboard v1alpha1,
+// the conversion error is returned as is to be retried by the queue
+func (c *ConverterController) updateGrafanaDashboardStatus(ctx context.Context, src *v1alpha1.GrafanaDashboard, p placement, convertErr error) error {
+	status := conversionStatus(src.Status.Conversion, src.Generation, []string{p.targetName(src, src.Name)}, convertErr)
+	if apiequality.Semantic.DeepEqual(src.Status.Conversion, status) {
+		return convertErr
+	}
+	updated := src.DeepCopy()
+	updated.Status.Conversion = status
+	if _, err := c.v1alpha1clientset.IntegreatlyV1alpha1().GrafanaDashboards(src.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return errors.Join(convertErr, fmt.Errorf("cannot update status of GrafanaDashboard: %w", err))
+	}
+	return convertErr
+}
+
+// recordGrafanaDashboardReferences annotates GrafanaDashboard v1alpha1 with references to the GrafanaDashboard v1beta1 objects converted from it
+func (c *ConverterController) recordGrafanaDashboardReferences(ctx context.Context, l logr.Logger, src *v1alpha1.GrafanaDashboard, p placement) error {
+	names := []string{p.targetName(src, src.Name)}
+	if err := c.deleteRetargetedObjects(ctx, l, src, "GrafanaDashboard", p.conf.DeletionPolicy.Dashboard, p.namespace, names, c.deleteConvertedGrafanaDashboard); err != nil {
+		return err
+	}
+	patch, err := convertedObjectsPatch(src, "GrafanaDashboard", p.namespace, names)
+	if err != nil || patch == nil {
+		return err
+	}
+	if _, err = c.v1alpha1clientset.IntegreatlyV1alpha1().GrafanaDashboards(src.Namespace).Patch(ctx, src.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("cannot record converted objects on GrafanaDashboard: %w", err)
+	}
+	return nil
+}
+
+// deleteGrafanaDashboard propagates deletion of GrafanaDashboard v1alpha1 to v1beta1
+func (c *ConverterController) deleteGrafanaDashboard(ctx context.Context, l logr.Logger, src *v1alpha1.GrafanaDashboard, p placement) error {
+	namespace, name := p.namespace, p.targetName(src, src.Name)
+	if policy := c.convertedDeletionPolicy(p.conf.DeletionPolicy.Dashboard, src, namespace); policy != DeletionPolicyDelete {
+		l.Info(fmt.Sprintf("GrafanaDashboard has been deleted or skipped, converted GrafanaDashboard is left to %q deletion policy", policy))
+		if policy == DeletionPolicyOrphan {
+			c.recordOrphaned(v1alpha1.GrafanaDashboardKind, src.Namespace+"/"+src.Name, "GrafanaDashboard", namespace, []string{name})
+		}
+		return nil
+	}
+	return c.deleteConvertedGrafanaDashboard(ctx, l, namespace, name)
+}
+
+// deleteConvertedGrafanaDashboard deletes GrafanaDashboard v1beta1 if it is managed by the converter
+func (c *ConverterController) deleteConvertedGrafanaDashboard(ctx context.Context, l logr.Logger, namespace, name string) error {
+	existingDashboard, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDashboards(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
 		if apierrs.IsNotFound(err) {
-			var createdDashboard *v1beta1.GrafanaDashboard
-			if createdDashboard, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDashboards(v1beta1Dashboard.Namespace).Create(ctx, v1beta1Dashboard, metav1.CreateOptions{}); err == nil {
-				l.Info(fmt.Sprintf("GrafanaDashboard %v/%v uid:%v has been created",
-					createdDashboard.GetNamespace(),
-					createdDashboard.GetName(),
-					createdDashboard.GetUID()))
-				return
-			}
+			return nil
 		}
-		l.Error(err, "cannot get existing GrafanaDashboard")
-		return
+		return fmt.Errorf("cannot get existing GrafanaDashboard: %w", err)
 	}
 	if !isConverterManaged(existingDashboard) {
-		l.Error(fmt.Errorf("resource is not managed by the converter"), "cannot update existing GrafanaDashboard")
-		return
+		l.Error(errNotManaged, "cannot delete existing GrafanaDashboard")
+		return nil
 	}
 
-	if apiequality.Semantic.DeepEqual(existingDashboard.Spec, v1beta1Dashboard.Spec) {
-		l.Info("no updates in GrafanaDashboards")
-		return
+	err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDashboards(namespace).Delete(ctx, name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &existingDashboard.UID},
+	})
+	if err != nil && !apierrs.IsNotFound(err) {
+		return fmt.Errorf("cannot delete GrafanaDashboard: %w", err)
 	}
+	l.Info(fmt.Sprintf("GrafanaDashboard %v/%v has been deleted", namespace, name))
+	return nil
+}
 
-	existingDashboard.Spec = v1beta1Dashboard.Spec
-	if existingDashboard.Annotations == nil {
-		existingDashboard.Annotations = make(map[string]string, len(v1beta1Dashboard.Annotations))
+// sweepGrafanaDashboards deletes converted GrafanaDashboards v1beta1 whose source no longer exists
+func (c *ConverterController) sweepGrafanaDashboards(ctx context.Context, namespace string) error {
+	dashboards, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDashboards(namespace).List(ctx, managedByOperatorSelector)
+	if err != nil {
+		return err
 	}
-	maps.Copy(existingDashboard.Annotations, v1beta1Dashboard.Annotations)
-	if existingDashboard.Labels == nil {
-		existingDashboard.Labels = make(map[string]string, len(v1beta1Dashboard.Labels))
+	var errs error
+	for _, dashboard := range dashboards.Items {
+		sourceName, ok := sourceNameOf(&dashboard)
+		if !ok {
+			sourceName = dashboard.Name
+		}
+		exists, err := c.convertedSourceExists(ctx, v1alpha1.GrafanaDashboardKind, &dashboard, sourceName)
+		if err != nil || exists {
+			continue
+		}
+		l := c.log.WithValues("kind", v1alpha1.GrafanaDashboardKind, "name", sourceName, "ns", dashboard.Namespace)
+		l.Info(fmt.Sprintf("source of GrafanaDashboard %v/%v no longer exists", dashboard.Namespace, dashboard.Name))
+		errs = errors.Join(errs, c.deleteConvertedGrafanaDashboard(ctx, l, dashboard.Namespace, dashboard.Name))
 	}
-	maps.Copy(existingDashboard.Labels, v1beta1Dashboard.Labels)
-	existingDashboard.OwnerReferences = v1beta1Dashboard.OwnerReferences
+	return errs
+}
 
-	var updatedDashboard *v1beta1.GrafanaDashboard
-	updatedDashboard, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDashboards(existingDashboard.Namespace).Update(ctx, existingDashboard, metav1.UpdateOptions{})
-	if err != nil {
-		l.Error(err, "cannot update GrafanaDashboard")
-		return
+// grafanaDashboardApplyConfiguration declares fields of the converted GrafanaDashboard owned by the converter
+func grafanaDashboardApplyConfiguration(dst *v1beta1.GrafanaDashboard) (*v1beta1ac.GrafanaDashboardApplyConfiguration, error) {
+	applyConfiguration := v1beta1ac.GrafanaDashboard(dst.Name, dst.Namespace)
+	applyConfiguration.ObjectMetaApplyConfiguration = objectMetaApplyConfiguration(dst.ObjectMeta)
+	applyConfiguration.Spec = &v1beta1ac.GrafanaDashboardSpecApplyConfiguration{}
+	return applyConfiguration, specApplyConfiguration(dst.Spec, applyConfiguration.Spec)
+}
+
+// grafanaDashboardConfig returns the configuration GrafanaDashboard v1alpha1 is converted with, the content cache
+// duration of legacy Grafanas is a part of the conversion hash, so their changes are applied
+func (c *ConverterController) grafanaDashboardConfig(src *v1alpha1.GrafanaDashboard, p placement) ConverterConfig {
+	conf := p.conf
+	conf.Defaults.Dashboard.ContentCacheDuration = c.contentCacheDuration(src, conf.Defaults.Dashboard)
+	return conf
+}
+
+// grafanaDashboardObjectMeta returns the metadata of GrafanaDashboard v1beta1 converted from GrafanaDashboard v1alpha1
+// with its provenance, it does not need the converted spec
+func (c *ConverterController) grafanaDashboardObjectMeta(src *v1alpha1.GrafanaDashboard, p placement) metav1.ObjectMeta {
+	conf := c.grafanaDashboardConfig(src, p)
+	meta := c.convertedObjectMeta(src, p.namespace, p.targetName(src, src.Name), conf.Propagation)
+	conf.Defaults.Dashboard.applyMetadata(&meta)
+	if conf.DeletionPolicy.Dashboard == DeletionPolicyOwnerReference {
+		c.setSourceOwnerReference(&meta, src, v1alpha1.GrafanaDashboardKind)
 	}
-	l.Info(fmt.Sprintf("GrafanaDashboard %v/%v uid:%v has been updated",
-		updatedDashboard.GetNamespace(),
-		updatedDashboard.GetName(),
-		updatedDashboard.GetUID()))
+	stampProvenance(&meta, src, v1alpha1.GrafanaDashboardKind, src.Hash(), conf)
+	return meta
 }
 
 // convertGrafanaDashboard creates GrafanaDashboard v1beta1 from GrafanaDashboard v1alpha1
-func (c *ConverterController) convertGrafanaDashboard(src *v1alpha1.GrafanaDashboard) (dst *v1beta1.GrafanaDashboard) {
+func (c *ConverterController) convertGrafanaDashboard(src *v1alpha1.GrafanaDashboard, p placement) (dst *v1beta1.GrafanaDashboard) {
 	c.log.Info(fmt.Sprintf("%s/%s conversion from %s to %s requested", src.Namespace, src.Name, v1alpha1.GroupVersion.String(), v1beta1.GroupVersion.String()))
+	conf := c.grafanaDashboardConfig(src, p)
+	defaults := conf.Defaults.Dashboard
 
 	dst = &v1beta1.GrafanaDashboard{
-		ObjectMeta: convertedObjectMeta(src, src.Name),
+		ObjectMeta: c.grafanaDashboardObjectMeta(src, p),
 	}
 
 	// Spec conversion
@@ -140,10 +345,16 @@ func (c *ConverterController) convertGrafanaDashboard(src *v1alpha1.GrafanaDashb
 	dst.Spec.Jsonnet = src.Spec.Jsonnet
 	dst.Spec.ConfigMapRef = src.Spec.ConfigMapRef
 	// src.Spec.GzipConfigMapRef
-	dst.Spec.InstanceSelector = c.ConverterConf.InstanceSelector
-	dst.Spec.AllowCrossNamespaceImport = ptr.To(true)
+	dst.Spec.InstanceSelector = conf.InstanceSelector
+	dst.Spec.AllowCrossNamespaceImport = allowCrossNamespaceImport(conf, defaults.KindDefaults)
 	dst.Spec.FolderTitle = src.Spec.CustomFolderName
-	dst.Spec.ResyncPeriod = v1beta1.DefaultResyncPeriod
+	if dst.Spec.FolderTitle == "" {
+		dst.Spec.FolderTitle = conf.FolderTitle
+	}
+	dst.Spec.ResyncPeriod = defaults.resyncPeriodString()
+	for _, env := range defaults.Envs {
+		dst.Spec.Envs = append(dst.Spec.Envs, *env.DeepCopy())
+	}
 
 	for _, plugin := range src.Spec.Plugins {
 		dst.Spec.Plugins = append(dst.Spec.Plugins, v1beta1.GrafanaPlugin{
@@ -165,8 +376,8 @@ func (c *ConverterController) convertGrafanaDashboard(src *v1alpha1.GrafanaDashb
 		}
 	}
 
-	if src.Spec.ContentCacheDuration != nil {
-		dst.Spec.ContentCacheDuration = *src.Spec.ContentCacheDuration
+	if defaults.ContentCacheDuration != nil {
+		dst.Spec.ContentCacheDuration = *defaults.ContentCacheDuration
 	}
 
 	c.log.Info(fmt.Sprintf("%s/%s has been successfully converted from %s to %s", src.Namespace, src.Name, v1alpha1.GroupVersion.String(), v1beta1.GroupVersion.String()))
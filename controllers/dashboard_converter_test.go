@@ -5,7 +5,6 @@ import (
 	"errors"
 	"testing"
 
-	v1beta1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/clientset/versioned/fake"
 	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
 	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
 	"github.com/go-logr/logr"
@@ -34,7 +33,7 @@ func TestConvertGrafanaDashboardMarksManagedCopyWithoutMutatingSource(t *testing
 	}
 	controller := &ConverterController{log: logr.Discard()}
 
-	converted := controller.convertGrafanaDashboard(source)
+	converted := controller.convertGrafanaDashboard(source, controller.place(v1alpha1.GrafanaDashboardKind, source))
 
 	assert.Equal(t, converterManagedValue, converted.Labels[converterManagedLabel])
 	assert.Equal(t, "sample", converted.Labels["product"])
@@ -54,7 +53,7 @@ func TestConvertGrafanaDatasourceMarksManagedCopyWithoutMutatingSource(t *testin
 	}
 	controller := &ConverterController{log: logr.Discard()}
 
-	converted, err := controller.convertGrafanaDatasource(source)
+	converted, err := controller.convertGrafanaDatasource(source, controller.place(v1alpha1.GrafanaDataSourceKind, source))
 
 	require.NoError(t, err)
 	require.Len(t, converted, 1)
@@ -72,7 +71,7 @@ func TestConvertGrafanaFolderMarksManagedCopyWithoutMutatingSource(t *testing.T)
 	}
 	controller := &ConverterController{log: logr.Discard()}
 
-	converted := controller.convertGrafanaFolder(source)
+	converted := controller.convertGrafanaFolder(source, controller.place(v1alpha1.GrafanaFolderKind, source))
 
 	assert.Equal(t, converterManagedValue, converted.Labels[converterManagedLabel])
 	assert.Equal(t, map[string]string{"product": "sample"}, source.Labels)
@@ -91,7 +90,7 @@ func TestConvertGrafanaNotificationChannelMarksManagedCopyWithoutMutatingSource(
 	}
 	controller := &ConverterController{log: logr.Discard()}
 
-	converted, err := controller.convertGrafanaNotificationChannel(source)
+	converted, err := controller.convertGrafanaNotificationChannel(source, controller.place(v1alpha1.GrafanaNotificationChannelKind, source))
 
 	require.NoError(t, err)
 	assert.Equal(t, converterManagedValue, converted.Labels[converterManagedLabel])
@@ -103,14 +102,16 @@ func TestCreateGrafanaDashboardDoesNotAdoptUnmarkedCollision(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sample-dashboard", Namespace: "product-a"},
 		Spec:       v1beta1.GrafanaDashboardSpec{Json: "foreign"},
 	}
-	client := v1beta1fake.NewSimpleClientset(existing)
+	client := newFakeV1beta1Clientset(existing)
 	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
 	source := &v1alpha1.GrafanaDashboard{
 		ObjectMeta: metav1.ObjectMeta{Name: existing.Name, Namespace: existing.Namespace},
 		Spec:       v1alpha1.GrafanaDashboardSpec{Json: "converted"},
 	}
 
-	controller.createGrafanaDashboard(source)
+	err := controller.reconcileGrafanaDashboard(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDashboardKind, source), false)
+	assert.ErrorIs(t, err, errNotManaged)
+	assert.True(t, isPermanent(err))
 
 	actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaDashboards(existing.Namespace).Get(
 		context.Background(), existing.Name, metav1.GetOptions{},
@@ -129,14 +130,14 @@ func TestCreateGrafanaDashboardUpdatesMarkedCopy(t *testing.T) {
 		},
 		Spec: v1beta1.GrafanaDashboardSpec{Json: "old"},
 	}
-	client := v1beta1fake.NewSimpleClientset(existing)
+	client := newFakeV1beta1Clientset(existing)
 	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
 	source := &v1alpha1.GrafanaDashboard{
 		ObjectMeta: metav1.ObjectMeta{Name: existing.Name, Namespace: existing.Namespace},
 		Spec:       v1alpha1.GrafanaDashboardSpec{Json: "new"},
 	}
 
-	controller.createGrafanaDashboard(source)
+	require.NoError(t, controller.reconcileGrafanaDashboard(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDashboardKind, source), false))
 
 	actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaDashboards(existing.Namespace).Get(
 		context.Background(), existing.Name, metav1.GetOptions{},
@@ -155,9 +156,9 @@ func TestCreateGrafanaDashboardHandlesUpdateErrorWithoutPanic(t *testing.T) {
 		},
 		Spec: v1beta1.GrafanaDashboardSpec{Json: "old"},
 	}
-	client := v1beta1fake.NewSimpleClientset(existing)
-	client.PrependReactor("update", "grafanadashboards", func(k8stesting.Action) (bool, runtime.Object, error) {
-		return true, nil, errors.New("API update failed")
+	client := newFakeV1beta1Clientset(existing)
+	client.PrependReactor("patch", "grafanadashboards", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("API apply failed")
 	})
 	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
 	source := &v1alpha1.GrafanaDashboard{
@@ -166,7 +167,7 @@ func TestCreateGrafanaDashboardHandlesUpdateErrorWithoutPanic(t *testing.T) {
 	}
 
 	assert.NotPanics(t, func() {
-		controller.createGrafanaDashboard(source)
+		assert.Error(t, controller.reconcileGrafanaDashboard(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDashboardKind, source), false))
 	})
 }
 
@@ -177,7 +178,7 @@ func TestCreateGrafanaDatasourceDoesNotAdoptUnmarkedCollision(t *testing.T) {
 			Datasource: &v1beta1.GrafanaDatasourceInternal{Name: "foreign"},
 		},
 	}
-	client := v1beta1fake.NewSimpleClientset(existing)
+	client := newFakeV1beta1Clientset(existing)
 	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
 	source := &v1alpha1.GrafanaDataSource{
 		ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: existing.Namespace},
@@ -186,7 +187,9 @@ func TestCreateGrafanaDatasourceDoesNotAdoptUnmarkedCollision(t *testing.T) {
 		},
 	}
 
-	controller.createGrafanaDatasource(source)
+	err := controller.reconcileGrafanaDatasource(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDataSourceKind, source), false)
+	assert.ErrorIs(t, err, errNotManaged)
+	assert.True(t, isPermanent(err))
 
 	actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaDatasources(existing.Namespace).Get(
 		context.Background(), existing.Name, metav1.GetOptions{},
@@ -207,11 +210,11 @@ func TestCreateGrafanaDatasourceHandlesUpdateErrorWithoutPanic(t *testing.T) {
 			Datasource: &v1beta1.GrafanaDatasourceInternal{Name: "old"},
 		},
 	}
-	client := v1beta1fake.NewSimpleClientset(existing)
+	client := newFakeV1beta1Clientset(existing)
 	updateAttempted := false
-	client.PrependReactor("update", "grafanadatasources", func(k8stesting.Action) (bool, runtime.Object, error) {
+	client.PrependReactor("patch", "grafanadatasources", func(k8stesting.Action) (bool, runtime.Object, error) {
 		updateAttempted = true
-		return true, nil, errors.New("API update failed")
+		return true, nil, errors.New("API apply failed")
 	})
 	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
 	source := &v1alpha1.GrafanaDataSource{
@@ -222,7 +225,7 @@ func TestCreateGrafanaDatasourceHandlesUpdateErrorWithoutPanic(t *testing.T) {
 	}
 
 	assert.NotPanics(t, func() {
-		controller.createGrafanaDatasource(source)
+		assert.Error(t, controller.reconcileGrafanaDatasource(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDataSourceKind, source), false))
 	})
 	assert.True(t, updateAttempted)
 }
@@ -232,14 +235,16 @@ func TestCreateGrafanaFolderDoesNotAdoptUnmarkedCollision(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"},
 		Spec:       v1beta1.GrafanaFolderSpec{Title: "foreign"},
 	}
-	client := v1beta1fake.NewSimpleClientset(existing)
+	client := newFakeV1beta1Clientset(existing)
 	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
 	source := &v1alpha1.GrafanaFolder{
 		ObjectMeta: metav1.ObjectMeta{Name: existing.Name, Namespace: existing.Namespace},
 		Spec:       v1alpha1.GrafanaFolderSpec{FolderName: "converted"},
 	}
 
-	controller.createGrafanaFolder(source)
+	err := controller.reconcileGrafanaFolder(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaFolderKind, source), false)
+	assert.ErrorIs(t, err, errNotManaged)
+	assert.True(t, isPermanent(err))
 
 	actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders(existing.Namespace).Get(
 		context.Background(), existing.Name, metav1.GetOptions{},
@@ -257,11 +262,11 @@ func TestCreateGrafanaFolderHandlesUpdateErrorWithoutPanic(t *testing.T) {
 		},
 		Spec: v1beta1.GrafanaFolderSpec{Title: "old"},
 	}
-	client := v1beta1fake.NewSimpleClientset(existing)
+	client := newFakeV1beta1Clientset(existing)
 	updateAttempted := false
-	client.PrependReactor("update", "grafanafolders", func(k8stesting.Action) (bool, runtime.Object, error) {
+	client.PrependReactor("patch", "grafanafolders", func(k8stesting.Action) (bool, runtime.Object, error) {
 		updateAttempted = true
-		return true, nil, errors.New("API update failed")
+		return true, nil, errors.New("API apply failed")
 	})
 	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
 	source := &v1alpha1.GrafanaFolder{
@@ -270,7 +275,7 @@ func TestCreateGrafanaFolderHandlesUpdateErrorWithoutPanic(t *testing.T) {
 	}
 
 	assert.NotPanics(t, func() {
-		controller.createGrafanaFolder(source)
+		assert.Error(t, controller.reconcileGrafanaFolder(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaFolderKind, source), false))
 	})
 	assert.True(t, updateAttempted)
 }
@@ -280,7 +285,7 @@ func TestCreateGrafanaNotificationChannelDoesNotAdoptUnmarkedCollision(t *testin
 		ObjectMeta: metav1.ObjectMeta{Name: "sample-contact-point", Namespace: "product-a"},
 		Spec:       v1beta1.GrafanaContactPointSpec{Name: "foreign", Type: "email"},
 	}
-	client := v1beta1fake.NewSimpleClientset(existing)
+	client := newFakeV1beta1Clientset(existing)
 	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
 	source := &v1alpha1.GrafanaNotificationChannel{
 		ObjectMeta: metav1.ObjectMeta{Name: existing.Name, Namespace: existing.Namespace},
@@ -289,7 +294,9 @@ func TestCreateGrafanaNotificationChannelDoesNotAdoptUnmarkedCollision(t *testin
 		},
 	}
 
-	controller.createGrafanaNotificationChannel(source)
+	err := controller.reconcileGrafanaNotificationChannel(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaNotificationChannelKind, source), false)
+	assert.ErrorIs(t, err, errNotManaged)
+	assert.True(t, isPermanent(err))
 
 	actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaContactPoints(existing.Namespace).Get(
 		context.Background(), existing.Name, metav1.GetOptions{},
@@ -307,11 +314,11 @@ func TestCreateGrafanaNotificationChannelHandlesUpdateErrorWithoutPanic(t *testi
 		},
 		Spec: v1beta1.GrafanaContactPointSpec{Name: "old", Type: "email"},
 	}
-	client := v1beta1fake.NewSimpleClientset(existing)
+	client := newFakeV1beta1Clientset(existing)
 	updateAttempted := false
-	client.PrependReactor("update", "grafanacontactpoints", func(k8stesting.Action) (bool, runtime.Object, error) {
+	client.PrependReactor("patch", "grafanacontactpoints", func(k8stesting.Action) (bool, runtime.Object, error) {
 		updateAttempted = true
-		return true, nil, errors.New("API update failed")
+		return true, nil, errors.New("API apply failed")
 	})
 	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
 	source := &v1alpha1.GrafanaNotificationChannel{
@@ -322,25 +329,31 @@ func TestCreateGrafanaNotificationChannelHandlesUpdateErrorWithoutPanic(t *testi
 	}
 
 	assert.NotPanics(t, func() {
-		controller.createGrafanaNotificationChannel(source)
+		assert.Error(t, controller.reconcileGrafanaNotificationChannel(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaNotificationChannelKind, source), false))
 	})
 	assert.True(t, updateAttempted)
 }
 
-func TestCreateGrafanaNotificationChannelHandlesAlreadyExistsNotFoundRace(t *testing.T) {
-	client := v1beta1fake.NewSimpleClientset()
+func TestCreateGrafanaNotificationChannelAppliesConcurrentlyCreatedCopy(t *testing.T) {
+	// the contact point is created by another converter replica after the converter did not find it
+	client := newFakeV1beta1Clientset(&v1beta1.GrafanaContactPoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sample-contact-point",
+			Namespace: "product-a",
+			Labels:    map[string]string{converterManagedLabel: converterManagedValue},
+		},
+	})
 	createAttempts := 0
 	client.PrependReactor("create", "grafanacontactpoints", func(action k8stesting.Action) (bool, runtime.Object, error) {
 		createAttempts++
-		createAction := action.(k8stesting.CreateAction)
-		return true, nil, apierrs.NewAlreadyExists(
-			schema.GroupResource{Group: v1beta1.GroupVersion.Group, Resource: "grafanacontactpoints"},
-			createAction.GetObject().(metav1.Object).GetName(),
-		)
+		return false, nil, nil
 	})
 	getAttempts := 0
 	client.PrependReactor("get", "grafanacontactpoints", func(action k8stesting.Action) (bool, runtime.Object, error) {
 		getAttempts++
+		if getAttempts > 1 {
+			return false, nil, nil
+		}
 		getAction := action.(k8stesting.GetAction)
 		return true, nil, apierrs.NewNotFound(
 			schema.GroupResource{Group: v1beta1.GroupVersion.Group, Resource: "grafanacontactpoints"},
@@ -356,8 +369,12 @@ func TestCreateGrafanaNotificationChannelHandlesAlreadyExistsNotFoundRace(t *tes
 	}
 
 	assert.NotPanics(t, func() {
-		controller.createGrafanaNotificationChannel(source)
+		assert.NoError(t, controller.reconcileGrafanaNotificationChannel(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaNotificationChannelKind, source), false))
 	})
-	assert.Equal(t, 2, createAttempts)
-	assert.Equal(t, 1, getAttempts)
+	assert.Equal(t, 0, createAttempts)
+	actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaContactPoints("product-a").Get(
+		context.Background(), "sample-contact-point", metav1.GetOptions{},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "sample", actual.Spec.Name)
 }
@@ -4,144 +4,385 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"maps"
 	"regexp"
 	"strings"
+	"time"
 
+	v1beta1ac "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/applyconfiguration/operator/v1beta1"
 	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
 	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
 	"github.com/go-logr/logr"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
 )
 
 var reg = regexp.MustCompile(`[^A-Za-z0-9.-]`)
 
-// createGrafanaDatasource converts GrafanaDatasource v1alpha1 to v1beta1
-func (c *ConverterController) createGrafanaDatasource(datasource interface{}) {
-	alphaDatasource, ok := datasource.(*v1alpha1.GrafanaDataSource)
-	if !ok {
-		c.log.Error(fmt.Errorf("type assertion failed"), "cannot cast to v1alpha1 GrafanaDataSource")
-		return
+// getGrafanaDatasource returns GrafanaDataSource v1alpha1 from informer caches
+func (c *ConverterController) getGrafanaDatasource(namespace, name string) (*v1alpha1.GrafanaDataSource, error) {
+	for _, informerFactory := range c.sourceInformers() {
+		datasource, err := informerFactory.Integreatly().V1alpha1().GrafanaDataSources().Lister().GrafanaDataSources(namespace).Get(name)
+		if err == nil || !apierrors.IsNotFound(err) {
+			return datasource, err
+		}
 	}
+	return nil, apierrors.NewNotFound(v1alpha1.GroupVersion.WithResource("grafanadatasources").GroupResource(), name)
+}
 
-	l := c.log.WithValues("kind", v1alpha1.GrafanaDataSourceKind, "name", alphaDatasource.Name, "ns", alphaDatasource.Namespace)
-
-	crs, err := c.convertGrafanaDatasource(alphaDatasource)
+// syncGrafanaDatasource converts GrafanaDataSource v1alpha1 with the key to v1beta1
+// or propagates its deletion if it no longer exists
+func (c *ConverterController) syncGrafanaDatasource(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
-		l.Error(err, "cannot convert some GrafanaDatasource at create")
+		c.log.Error(err, "cannot parse GrafanaDataSource key", "key", key)
+		return nil
 	}
-	var createdDatasource *v1beta1.GrafanaDatasource
-	for _, cr := range crs {
-		l.Info(fmt.Sprintf("start creating GrafanaDatasource %s/%s", cr.Namespace, cr.Name))
-		createdDatasource, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDatasources(cr.Namespace).Create(context.Background(), cr, metav1.CreateOptions{})
-		if err != nil {
-			if apierrors.IsAlreadyExists(err) {
-				c.updateGrafanaDatasource(nil, cr)
-				continue
+	l := c.log.WithValues("kind", v1alpha1.GrafanaDataSourceKind, "name", name, "ns", namespace)
+
+	start := time.Now()
+	alphaDatasource, err := c.getGrafanaDatasource(namespace, name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			if c.queue(v1alpha1.GrafanaDataSourceKind).isDriftOnly(key) {
+				// deletion of the source is handled when its own event is processed
+				return nil
+			}
+			if deselected, err := c.deselectedBySourceSelector(ctx, v1alpha1.GrafanaDataSourceKind, namespace, name); err != nil || deselected {
+				// the source stopped matching the source selector, objects converted from it are left as they are
+				return err
+			}
+			// the last known state is needed to find datasources converted before the source annotation was introduced
+			lastState, _ := c.queue(v1alpha1.GrafanaDataSourceKind).lastState(key)
+			deleted, ok := lastState.(*v1alpha1.GrafanaDataSource)
+			if !ok {
+				deleted = &v1alpha1.GrafanaDataSource{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
 			}
-			l.Error(err, fmt.Sprintf("cannot create GrafanaDatasource %s/%s", cr.Namespace, cr.Name))
+			err = c.deleteGrafanaDatasource(ctx, l, deleted, c.place(v1alpha1.GrafanaDataSourceKind, deleted))
+			observeConversion(v1alpha1.GrafanaDataSourceKind, operationDelete, start, err)
+			return err
+		}
+		return err
+	}
+	passes, err := c.passesSourceFilter(v1alpha1.GrafanaDataSourceKind, alphaDatasource)
+	if err != nil {
+		return permanent(fmt.Errorf("cannot evaluate source filter of GrafanaDataSource: %w", err))
+	}
+	if !passes {
+		l.Info("GrafanaDataSource does not pass the source filter, it is not converted")
+		return nil
+	}
+	p := c.place(v1alpha1.GrafanaDataSourceKind, alphaDatasource)
+	control, err := controlOf(alphaDatasource)
+	switch {
+	case err != nil:
+		err = permanent(fmt.Errorf("invalid control annotations of GrafanaDataSource: %w", err))
+	case control.paused:
+		l.Info("GrafanaDataSource is paused by annotation, it is not synced")
+		return nil
+	case control.skip:
+		l.Info("GrafanaDataSource is skipped by annotation, it is not converted")
+		err = c.deleteGrafanaDatasource(ctx, l, alphaDatasource, p)
+		observeConversion(v1alpha1.GrafanaDataSourceKind, operationDelete, start, err)
+		return err
+	default:
+		err = c.reconcileGrafanaDatasource(ctx, l, alphaDatasource, p, c.reportsDrift(v1alpha1.GrafanaDataSourceKind, key))
+	}
+	c.recordConversionFailed(alphaDatasource, "GrafanaDatasource", p.namespace, convertedGrafanaDatasourceNames(alphaDatasource, p), err)
+	err = c.updateGrafanaDataSourceStatus(ctx, alphaDatasource, p, err)
+	if err == nil {
+		err = c.recordGrafanaDataSourceReferences(ctx, l, alphaDatasource, p)
+	}
+	observeConversion(v1alpha1.GrafanaDataSourceKind, operationConvert, start, err)
+	return err
+}
+
+// reconcileGrafanaDatasource creates or updates GrafanaDatasources v1beta1 converted from GrafanaDataSource v1alpha1
+// and deletes the ones which were removed from the source,
+// with reportOnly set it only reports the drift of converted objects
+func (c *ConverterController) reconcileGrafanaDatasource(ctx context.Context, l logr.Logger, alphaDatasource *v1alpha1.GrafanaDataSource, p placement, reportOnly bool) error {
+	l.Info(fmt.Sprintf("start converting GrafanaDatasource %s to %s", v1alpha1.GroupVersion.String(), v1beta1.GroupVersion.String()))
+	if p.err != nil {
+		return p.err
+	}
+	var errs error
+	v1beta1Datasources, err := c.convertGrafanaDatasource(alphaDatasource, p)
+	if err != nil {
+		// the source has to be fixed, retries will not help, other datasources are still converted
+		errs = permanent(fmt.Errorf("cannot convert some GrafanaDatasource: %w", err))
+	} else if c.convertedDeletionPolicy(p.conf.DeletionPolicy.Datasource, alphaDatasource, p.namespace) == DeletionPolicyDelete && !reportOnly {
+		if err = c.deleteConvertedGrafanaDatasources(ctx, l, alphaDatasource, p.namespace, v1beta1Datasources); err != nil {
+			return err
+		}
+	}
+
+	for _, ds := range v1beta1Datasources {
+		if ds == nil {
 			continue
 		}
-		l.Info(fmt.Sprintf("GrafanaDashboard %v/%v uid:%v has been created",
-			createdDatasource.GetNamespace(),
-			createdDatasource.GetName(),
-			createdDatasource.GetUID()))
+		errs = errors.Join(errs, c.reconcileConvertedGrafanaDatasource(ctx, l, alphaDatasource, p, ds, reportOnly))
 	}
+	return errs
 }
 
-// updateGrafanaDatasource converts GrafanaDatasource v1alpha1 to v1beta1
-func (c *ConverterController) updateGrafanaDatasource(old, new interface{}) {
-	var err error
-	var v1alpha1Datasource *v1alpha1.GrafanaDataSource
-	var v1beta1Datasource *v1beta1.GrafanaDatasource
-	var v1beta1Datasources []*v1beta1.GrafanaDatasource
-	var ok bool
-	var l logr.Logger
-	v1alpha1Datasource, ok = new.(*v1alpha1.GrafanaDataSource)
-	if ok && old != nil {
-		l = c.log.WithValues("kind", v1alpha1.GrafanaDataSourceKind, "name", v1alpha1Datasource.Name, "ns", v1alpha1Datasource.Namespace)
-		var v1alpha1DatasourceOld *v1alpha1.GrafanaDataSource
-		v1alpha1DatasourceOld, ok = old.(*v1alpha1.GrafanaDataSource)
-		if !ok {
-			c.log.Error(fmt.Errorf("type assertion failed"), "cannot cast to v1alpha1 GrafanaDataSource")
-			return
+// reconcileConvertedGrafanaDatasource creates or updates one GrafanaDatasource v1beta1 converted from the source
+func (c *ConverterController) reconcileConvertedGrafanaDatasource(ctx context.Context, l logr.Logger, src *v1alpha1.GrafanaDataSource, p placement, ds *v1beta1.GrafanaDatasource, reportOnly bool) error {
+	var previous *v1beta1.GrafanaDatasource
+	existingDatasource, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDatasources(ds.Namespace).Get(ctx, ds.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("cannot get existing GrafanaDatasource %s/%s: %w", ds.Namespace, ds.Name, err)
+		}
+		if reportOnly {
+			l.Info(fmt.Sprintf("GrafanaDatasource %v/%v has been deleted, the drift is reported and not restored", ds.Namespace, ds.Name))
+			return nil
+		}
+	} else {
+		previous = existingDatasource
+		if !c.canUpdate(existingDatasource) {
+			c.recordNotManaged(src, existingDatasource, "GrafanaDatasource", existingDatasource.Namespace, existingDatasource.Name)
+			return permanent(fmt.Errorf("cannot update existing GrafanaDatasource %s/%s: %w", ds.Namespace, ds.Name, errNotManaged))
 		}
-		if apiequality.Semantic.DeepEqual(v1alpha1DatasourceOld.Spec, v1alpha1Datasource.Spec) {
-			l.Info("no diffs in GrafanaDatasource")
-			return
+		if !p.conf.Strategy.updatesExisting() {
+			l.Info(fmt.Sprintf("GrafanaDatasource %v/%v already exists and is not updated with %q strategy", existingDatasource.Namespace, existingDatasource.Name, SyncStrategyCreateOnly))
+			return nil
 		}
-		l.Info(fmt.Sprintf("start converting GrafanaDatasource %s to %s", v1alpha1.GroupVersion.String(), v1beta1.GroupVersion.String()))
-		v1beta1Datasources, err = c.convertGrafanaDatasource(v1alpha1Datasource)
+		if reportOnly {
+			if !apiequality.Semantic.DeepEqual(existingDatasource.Spec, ds.Spec) || c.metadataDrifted(existingDatasource, ds) {
+				l.Info(fmt.Sprintf("GrafanaDatasource %v/%v has drifted from the converted state, the drift is reported and not restored", existingDatasource.Namespace, existingDatasource.Name))
+			}
+			return nil
+		}
+		if c.isUpToDate(v1alpha1.GrafanaDataSourceKind, existingDatasource, ds) {
+			l.Info(fmt.Sprintf("GrafanaDatasource %v/%v is up to date with its source, it is not applied again", existingDatasource.Namespace, existingDatasource.Name))
+			return nil
+		}
+		adopted, err := backupPreviousSpec(&ds.ObjectMeta, existingDatasource, existingDatasource.Spec)
 		if err != nil {
-			l.Error(err, "cannot convert some GrafanaDatasource at update")
+			return permanent(fmt.Errorf("cannot adopt existing GrafanaDatasource %s/%s: %w", ds.Namespace, ds.Name, err))
 		}
-	} else {
-		v1beta1Datasource, ok = new.(*v1beta1.GrafanaDatasource)
-		if !ok {
-			c.log.Error(fmt.Errorf("type assertion failed"), "GrafanaDatasource has an incorrect apiVersion")
-			return
+		if adopted {
+			l.Info(fmt.Sprintf("GrafanaDatasource %v/%v is adopted by the converter, its previous spec is kept in %s annotation", existingDatasource.Namespace, existingDatasource.Name, previousSpecAnnotationKey))
 		}
-		l = c.log.WithValues("kind", v1alpha1.GrafanaDataSourceKind, "name", v1beta1Datasource.Name, "ns", v1beta1Datasource.Namespace)
-		v1beta1Datasources = append(v1beta1Datasources, v1beta1Datasource)
-	}
-
-	ctx := context.Background()
-	var existingDatasource *v1beta1.GrafanaDatasource
-	for _, ds := range v1beta1Datasources {
-		existingDatasource, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDatasources(ds.Namespace).Get(ctx, ds.Name, metav1.GetOptions{})
+		ownershipPatch, err := fieldOwnershipPatch(existingDatasource)
 		if err != nil {
-			if apierrors.IsNotFound(err) {
-				var createdDatasource *v1beta1.GrafanaDatasource
-				if createdDatasource, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDatasources(ds.Namespace).Create(ctx, ds, metav1.CreateOptions{}); err == nil {
-					l.Info(fmt.Sprintf("GrafanaDashboard %v/%v uid:%v has been created",
-						createdDatasource.GetNamespace(),
-						createdDatasource.GetName(),
-						createdDatasource.GetUID()))
-					continue
-				}
+			return permanent(fmt.Errorf("cannot migrate field ownership of existing GrafanaDatasource: %w", err))
+		}
+		if ownershipPatch != nil {
+			if _, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDatasources(existingDatasource.Namespace).Patch(ctx, existingDatasource.Name, types.JSONPatchType, ownershipPatch, metav1.PatchOptions{}); err != nil {
+				return fmt.Errorf("cannot migrate field ownership of existing GrafanaDatasource: %w", err)
+			}
+			l.Info(fmt.Sprintf("GrafanaDatasource %v/%v fields updated before server-side apply are now owned by %s", existingDatasource.Namespace, existingDatasource.Name, fieldManager))
+		}
+		if instanceSelectorChanged(existingDatasource.Spec.InstanceSelector, ds.Spec.InstanceSelector) {
+			client := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDatasources(existingDatasource.Namespace)
+			err = recreateConverted(ctx, l, "GrafanaDatasource", existingDatasource,
+				func(ctx context.Context, options metav1.DeleteOptions) error {
+					return client.Delete(ctx, existingDatasource.Name, options)
+				},
+				func(ctx context.Context) error {
+					_, err := client.Get(ctx, existingDatasource.Name, metav1.GetOptions{})
+					return err
+				})
+			if err != nil {
+				return err
 			}
-			l.Error(err, "cannot get existing GrafanaDatasource")
+		}
+	}
+
+	applyConfiguration, err := grafanaDatasourceApplyConfiguration(ds)
+	if err != nil {
+		return permanent(fmt.Errorf("cannot build apply configuration of GrafanaDatasource %s/%s: %w", ds.Namespace, ds.Name, err))
+	}
+	appliedDatasource, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDatasources(ds.Namespace).Apply(ctx, applyConfiguration, applyOptions)
+	if err != nil {
+		return fmt.Errorf("cannot apply GrafanaDatasource %s/%s: %w", ds.Namespace, ds.Name, err)
+	}
+	l.Info(fmt.Sprintf("GrafanaDatasource %v/%v uid:%v has been applied",
+		appliedDatasource.GetNamespace(),
+		appliedDatasource.GetName(),
+		appliedDatasource.GetUID()))
+	var dropped []string
+	for _, fields := range src.Spec.Datasources {
+		if grafanaDatasourceName(p.targetName(src, src.Namespace), fields.Name) == ds.Name {
+			dropped = droppedGrafanaDatasourceFields(fields)
+		}
+	}
+	// applies which change nothing keep the resource version and are not recorded as conversions
+	if previous == nil || appliedDatasource.ResourceVersion != previous.ResourceVersion {
+		c.recordConverted(src, appliedDatasource, "GrafanaDatasource", appliedDatasource.Namespace, appliedDatasource.Name, dropped)
+	}
+	return nil
+}
+
+// updateGrafanaDataSourceStatus writes the result of the conversion to the status of GrafanaDataSource v1alpha1,
+// the conversion error is returned as is to be retried by the queue
+func (c *ConverterController) updateGrafanaDataSourceStatus(ctx context.Context, src *v1alpha1.GrafanaDataSource, p placement, convertErr error) error {
+	status := conversionStatus(src.Status.Conversion, src.Generation, convertedGrafanaDatasourceNames(src, p), convertErr)
+	if apiequality.Semantic.DeepEqual(src.Status.Conversion, status) {
+		return convertErr
+	}
+	updated := src.DeepCopy()
+	updated.Status.Conversion = status
+	if _, err := c.v1alpha1clientset.IntegreatlyV1alpha1().GrafanaDataSources(src.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return errors.Join(convertErr, fmt.Errorf("cannot update status of GrafanaDataSource: %w", err))
+	}
+	return convertErr
+}
+
+// recordGrafanaDataSourceReferences annotates GrafanaDataSource v1alpha1 with references to the GrafanaDatasource v1beta1 objects converted from it
+func (c *ConverterController) recordGrafanaDataSourceReferences(ctx context.Context, l logr.Logger, src *v1alpha1.GrafanaDataSource, p placement) error {
+	names := convertedGrafanaDatasourceNames(src, p)
+	if err := c.deleteRetargetedObjects(ctx, l, src, "GrafanaDatasource", p.conf.DeletionPolicy.Datasource, p.namespace, names, c.deleteConvertedGrafanaDatasource); err != nil {
+		return err
+	}
+	patch, err := convertedObjectsPatch(src, "GrafanaDatasource", p.namespace, names)
+	if err != nil || patch == nil {
+		return err
+	}
+	if _, err = c.v1alpha1clientset.IntegreatlyV1alpha1().GrafanaDataSources(src.Namespace).Patch(ctx, src.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("cannot record converted objects on GrafanaDataSource: %w", err)
+	}
+	return nil
+}
+
+// deleteGrafanaDatasource propagates deletion of GrafanaDataSource v1alpha1 to v1beta1
+func (c *ConverterController) deleteGrafanaDatasource(ctx context.Context, l logr.Logger, alphaDatasource *v1alpha1.GrafanaDataSource, p placement) error {
+	if policy := c.convertedDeletionPolicy(p.conf.DeletionPolicy.Datasource, alphaDatasource, p.namespace); policy != DeletionPolicyDelete {
+		l.Info(fmt.Sprintf("GrafanaDataSource has been deleted or skipped, converted GrafanaDatasources are left to %q deletion policy", policy))
+		if policy == DeletionPolicyOrphan {
+			c.recordOrphaned(v1alpha1.GrafanaDataSourceKind, alphaDatasource.Namespace+"/"+alphaDatasource.Name, "GrafanaDatasource", p.namespace, convertedGrafanaDatasourceNames(alphaDatasource, p))
+		}
+		return nil
+	}
+	return c.deleteConvertedGrafanaDatasources(ctx, l, alphaDatasource, p.namespace, nil)
+}
+
+// deleteConvertedGrafanaDatasources deletes GrafanaDatasources v1beta1 converted from the source into the namespace
+// except the ones which are still desired
+func (c *ConverterController) deleteConvertedGrafanaDatasources(ctx context.Context, l logr.Logger, src *v1alpha1.GrafanaDataSource, namespace string, desired []*v1beta1.GrafanaDatasource) error {
+	keep := make(map[string]bool, len(desired))
+	for _, ds := range desired {
+		if ds != nil {
+			keep[ds.Name] = true
+		}
+	}
+	// datasources converted before the source annotation was introduced can be found only by name
+	legacy := make(map[string]bool, len(src.Spec.Datasources))
+	for _, ds := range src.Spec.Datasources {
+		legacy[grafanaDatasourceName(src.Namespace, ds.Name)] = true
+	}
+
+	existingDatasources, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDatasources(namespace).List(ctx, managedByOperatorSelector)
+	if err != nil {
+		return fmt.Errorf("cannot list existing GrafanaDatasources: %w", err)
+	}
+	var errs error
+	for _, existingDatasource := range existingDatasources.Items {
+		convertedFromSource := legacy[existingDatasource.Name]
+		if sourceName, ok := sourceNameOf(&existingDatasource); ok {
+			convertedFromSource = sourceName == src.Name && sourceNamespaceOf(&existingDatasource) == src.Namespace
+		}
+		if !convertedFromSource || keep[existingDatasource.Name] {
 			continue
 		}
-		if !isConverterManaged(existingDatasource) {
-			l.Error(fmt.Errorf("resource is not managed by the converter"), "cannot update existing GrafanaDatasource", "datasource", ds.Name)
+		err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDatasources(namespace).Delete(ctx, existingDatasource.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: &existingDatasource.UID},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = errors.Join(errs, fmt.Errorf("cannot delete GrafanaDatasource %s/%s: %w", existingDatasource.Namespace, existingDatasource.Name, err))
 			continue
 		}
+		l.Info(fmt.Sprintf("GrafanaDatasource %v/%v has been deleted", existingDatasource.Namespace, existingDatasource.Name))
+	}
+	return errs
+}
 
-		if apiequality.Semantic.DeepEqual(existingDatasource.Spec, ds.Spec) {
-			l.Info("no updates in GrafanaDatasource")
-			continue
+// deleteConvertedGrafanaDatasource deletes GrafanaDatasource v1beta1 if it is managed by the converter
+func (c *ConverterController) deleteConvertedGrafanaDatasource(ctx context.Context, l logr.Logger, namespace, name string) error {
+	existingDatasource, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDatasources(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
 		}
+		return fmt.Errorf("cannot get existing GrafanaDatasource: %w", err)
+	}
+	if !isConverterManaged(existingDatasource) {
+		l.Error(errNotManaged, "cannot delete existing GrafanaDatasource")
+		return nil
+	}
 
-		existingDatasource.Spec = ds.Spec
-		maps.Copy(existingDatasource.Annotations, ds.Annotations)
-		maps.Copy(existingDatasource.Labels, ds.Labels)
-		existingDatasource.OwnerReferences = ds.OwnerReferences
+	err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDatasources(namespace).Delete(ctx, name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &existingDatasource.UID},
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("cannot delete GrafanaDatasource %s/%s: %w", namespace, name, err)
+	}
+	l.Info(fmt.Sprintf("GrafanaDatasource %v/%v has been deleted", namespace, name))
+	return nil
+}
 
-		var updatedDatasource *v1beta1.GrafanaDatasource
-		updatedDatasource, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDatasources(existingDatasource.Namespace).Update(ctx, existingDatasource, metav1.UpdateOptions{})
-		if err != nil {
-			l.Error(err, "cannot update GrafanaDatasource")
+// sweepGrafanaDatasources deletes converted GrafanaDatasources v1beta1 whose source no longer exists
+func (c *ConverterController) sweepGrafanaDatasources(ctx context.Context, namespace string) error {
+	datasources, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaDatasources(namespace).List(ctx, managedByOperatorSelector)
+	if err != nil {
+		return err
+	}
+	var errs error
+	for _, datasource := range datasources.Items {
+		// the source of a datasource can not be derived from its name
+		sourceName, ok := sourceNameOf(&datasource)
+		if !ok {
+			continue
+		}
+		exists, err := c.convertedSourceExists(ctx, v1alpha1.GrafanaDataSourceKind, &datasource, sourceName)
+		if err != nil || exists {
 			continue
 		}
-		l.Info(fmt.Sprintf("GrafanaDashboard %v/%v uid:%v has been updated",
-			updatedDatasource.GetNamespace(),
-			updatedDatasource.GetName(),
-			updatedDatasource.GetUID()))
+		l := c.log.WithValues("kind", v1alpha1.GrafanaDataSourceKind, "name", sourceName, "ns", datasource.Namespace)
+		l.Info(fmt.Sprintf("source of GrafanaDatasource %v/%v no longer exists", datasource.Namespace, datasource.Name))
+		errs = errors.Join(errs, c.deleteConvertedGrafanaDatasource(ctx, l, datasource.Namespace, datasource.Name))
+	}
+	return errs
+}
+
+// grafanaDatasourceName builds the name of GrafanaDatasource v1beta1 converted from a datasource of GrafanaDataSource v1alpha1,
+// the prefix is the namespace of the source or its target name
+func grafanaDatasourceName(prefix, datasourceName string) string {
+	return fmt.Sprintf("%s-%s", prefix, reg.ReplaceAllString(strings.ToLower(datasourceName), "-"))
+}
+
+// convertedGrafanaDatasourceNames returns names of GrafanaDatasources v1beta1 converted from GrafanaDataSource v1alpha1,
+// the target name of the source replaces the namespace prefix of the names
+func convertedGrafanaDatasourceNames(src *v1alpha1.GrafanaDataSource, p placement) []string {
+	names := make([]string, 0, len(src.Spec.Datasources))
+	for _, ds := range src.Spec.Datasources {
+		names = append(names, grafanaDatasourceName(p.targetName(src, src.Namespace), ds.Name))
 	}
+	return names
+}
+
+// grafanaDatasourceApplyConfiguration declares fields of the converted GrafanaDatasource owned by the converter
+func grafanaDatasourceApplyConfiguration(dst *v1beta1.GrafanaDatasource) (*v1beta1ac.GrafanaDatasourceApplyConfiguration, error) {
+	applyConfiguration := v1beta1ac.GrafanaDatasource(dst.Name, dst.Namespace)
+	applyConfiguration.ObjectMetaApplyConfiguration = objectMetaApplyConfiguration(dst.ObjectMeta)
+	applyConfiguration.Spec = &v1beta1ac.GrafanaDatasourceSpecApplyConfiguration{}
+	return applyConfiguration, specApplyConfiguration(dst.Spec, applyConfiguration.Spec)
 }
 
 // convertGrafanaDatasource converts GrafanaDataSource from v1alpha1 to v1beta1
-func (c *ConverterController) convertGrafanaDatasource(src *v1alpha1.GrafanaDataSource) (dst []*v1beta1.GrafanaDatasource, errs error) {
+func (c *ConverterController) convertGrafanaDatasource(src *v1alpha1.GrafanaDataSource, p placement) (dst []*v1beta1.GrafanaDatasource, errs error) {
 	c.log.Info(fmt.Sprintf("%s/%s conversion from %s to %s requested", src.Namespace, src.Name, v1alpha1.GroupVersion.String(), v1beta1.GroupVersion.String()))
+	conf := p.conf
 
 	// Spec conversion
 	var jsonData, secureJsonData []byte
 	var err error
+	hash := contentHash(src.Spec)
 	dst = make([]*v1beta1.GrafanaDatasource, len(src.Spec.Datasources))
 	for i, ds := range src.Spec.Datasources {
 		if len(ds.CustomJsonData) != 0 {
@@ -165,8 +406,13 @@ func (c *ConverterController) convertGrafanaDatasource(src *v1alpha1.GrafanaData
 		}
 
 		betaDatasource := &v1beta1.GrafanaDatasource{
-			ObjectMeta: convertedObjectMeta(src, fmt.Sprintf("%s-%s", src.Namespace, reg.ReplaceAllString(strings.ToLower(ds.Name), "-"))),
+			ObjectMeta: c.convertedObjectMeta(src, p.namespace, grafanaDatasourceName(p.targetName(src, src.Namespace), ds.Name), conf.Propagation),
+		}
+		conf.Defaults.Datasource.applyMetadata(&betaDatasource.ObjectMeta)
+		if conf.DeletionPolicy.Datasource == DeletionPolicyOwnerReference {
+			c.setSourceOwnerReference(&betaDatasource.ObjectMeta, src, v1alpha1.GrafanaDataSourceKind)
 		}
+		stampProvenance(&betaDatasource.ObjectMeta, src, v1alpha1.GrafanaDataSourceKind, hash, conf)
 
 		uid := ds.Uid
 		if strings.Contains(ds.Name, "Prometheus") {
@@ -174,8 +420,8 @@ func (c *ConverterController) convertGrafanaDatasource(src *v1alpha1.GrafanaData
 		}
 
 		betaDatasource.Spec = v1beta1.GrafanaDatasourceSpec{
-			InstanceSelector:          c.ConverterConf.InstanceSelector,
-			AllowCrossNamespaceImport: ptr.To(true),
+			InstanceSelector:          conf.InstanceSelector,
+			AllowCrossNamespaceImport: allowCrossNamespaceImport(conf, conf.Defaults.Datasource),
 			Datasource: &v1beta1.GrafanaDatasourceInternal{
 				UID:            uid,
 				Name:           ds.Name,
@@ -192,7 +438,7 @@ func (c *ConverterController) convertGrafanaDatasource(src *v1alpha1.GrafanaData
 				JSONData:       jsonData,
 				SecureJSONData: secureJsonData,
 			},
-			ResyncPeriod: v1beta1.DefaultResyncPeriod,
+			ResyncPeriod: conf.Defaults.Datasource.resyncPeriodString(),
 		}
 		dst[i] = betaDatasource
 	}
@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// Defaults defines per kind fields of converted objects which v1alpha1 objects do not set
+type Defaults struct {
+	Dashboard           DashboardDefaults `json:"dashboard,omitempty" yaml:"dashboard,omitempty"`
+	Datasource          KindDefaults      `json:"datasource,omitempty" yaml:"datasource,omitempty"`
+	Folder              KindDefaults      `json:"folder,omitempty" yaml:"folder,omitempty"`
+	NotificationChannel KindDefaults      `json:"notification,omitempty" yaml:"notification,omitempty"`
+}
+
+// KindDefaults defines fields of converted objects of one kind which v1alpha1 objects do not set
+type KindDefaults struct {
+	// ResyncPeriod is how often the Grafana operator syncs converted objects, its own default is used if it is not set
+	ResyncPeriod *metav1.Duration `json:"resyncPeriod,omitempty" yaml:"resyncPeriod,omitempty"`
+	// AllowCrossNamespaceImport overrides whether Grafana instances of other namespaces import converted objects
+	AllowCrossNamespaceImport *bool `json:"allowCrossNamespaceImport,omitempty" yaml:"allowCrossNamespaceImport,omitempty"`
+	// Labels and Annotations are added to converted objects, labels and annotations of sources take precedence
+	Labels      map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+}
+
+// DashboardDefaults defines fields of converted dashboards which v1alpha1 dashboards do not set
+type DashboardDefaults struct {
+	KindDefaults `json:",inline" yaml:",inline"`
+	// ContentCacheDuration is used for dashboards which do not set contentCacheDuration
+	// and are not imported by a legacy Grafana which sets dashboardContentCacheDuration
+	ContentCacheDuration *metav1.Duration `json:"contentCacheDuration,omitempty" yaml:"contentCacheDuration,omitempty"`
+	// Envs are environment variables of converted dashboards
+	Envs []v1beta1.GrafanaDashboardEnv `json:"envs,omitempty" yaml:"envs,omitempty"`
+}
+
+// validate returns all problems of the defaults, objects converted with tenant isolation must not allow cross-namespace import
+func (d Defaults) validate(isolation IsolationMode) error {
+	errs := []error{
+		d.Dashboard.KindDefaults.validate("dashboard", isolation),
+		d.Datasource.validate("datasource", isolation),
+		d.Folder.validate("folder", isolation),
+		d.NotificationChannel.validate("notification", isolation),
+	}
+	if d.Dashboard.ContentCacheDuration != nil && d.Dashboard.ContentCacheDuration.Duration < 0 {
+		errs = append(errs, fmt.Errorf("defaults.dashboard.contentCacheDuration: must not be negative, got %s", d.Dashboard.ContentCacheDuration.Duration))
+	}
+	for i, env := range d.Dashboard.Envs {
+		if env.Name == "" {
+			errs = append(errs, fmt.Errorf("defaults.dashboard.envs[%d].name: must be set", i))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (d KindDefaults) validate(kind string, isolation IsolationMode) error {
+	var errs []error
+	if d.ResyncPeriod != nil && d.ResyncPeriod.Duration <= 0 {
+		errs = append(errs, fmt.Errorf("defaults.%s.resyncPeriod: must be positive, got %s", kind, d.ResyncPeriod.Duration))
+	}
+	if isolation == IsolationModeTenant && ptr.Deref(d.AllowCrossNamespaceImport, false) {
+		errs = append(errs, fmt.Errorf("defaults.%s.allowCrossNamespaceImport: must not be true with %q isolation mode", kind, IsolationModeTenant))
+	}
+	errs = append(errs, validateLabels("defaults."+kind+".labels", d.Labels)...)
+	errs = append(errs, validateAnnotations("defaults."+kind+".annotations", d.Annotations)...)
+	return errors.Join(errs...)
+}
+
+// resyncPeriod returns the configured resync period of converted objects, or the default of the Grafana operator
+func (d KindDefaults) resyncPeriod() metav1.Duration {
+	if d.ResyncPeriod != nil {
+		return *d.ResyncPeriod
+	}
+	return metav1.Duration{Duration: v1beta1.DefaultResyncPeriodDuration}
+}
+
+// resyncPeriodString returns the resync period of converted kinds which keep it as a string
+func (d KindDefaults) resyncPeriodString() string {
+	if d.ResyncPeriod != nil {
+		return d.ResyncPeriod.Duration.String()
+	}
+	return v1beta1.DefaultResyncPeriod
+}
+
+// applyMetadata adds default labels and annotations which the converted object does not have yet
+func (d KindDefaults) applyMetadata(meta *metav1.ObjectMeta) {
+	for key, value := range d.Labels {
+		if _, ok := meta.Labels[key]; !ok {
+			meta.Labels[key] = value
+		}
+	}
+	for key, value := range d.Annotations {
+		if _, ok := meta.Annotations[key]; !ok {
+			meta.Annotations[key] = value
+		}
+	}
+}
+
+// contentCacheDuration returns the content cache duration of the dashboard converted from the v1alpha1 dashboard:
+// its own one, the one of legacy Grafanas importing it or the default one
+func (c *ConverterController) contentCacheDuration(src *v1alpha1.GrafanaDashboard, defaults DashboardDefaults) *metav1.Duration {
+	if src.Spec.ContentCacheDuration != nil {
+		return src.Spec.ContentCacheDuration
+	}
+	if duration := c.legacyContentCacheDuration(src); duration != nil {
+		return duration
+	}
+	return defaults.ContentCacheDuration
+}
@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	v1beta1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/clientset/versioned/fake"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestDefaultsValidation(t *testing.T) {
+	_, err := ParseConfig([]byte(`
+isolationMode: tenant
+defaults:
+  dashboard:
+    resyncPeriod: 0s
+    contentCacheDuration: -1m
+    envs:
+    - value: prod
+  datasource:
+    allowCrossNamespaceImport: true
+    labels:
+      app.kubernetes.io/managed-by-operator: someone
+  folder:
+    annotations:
+      grafana-converter.qubership.org/hash: "0"
+`))
+	assert.ErrorContains(t, err, "defaults.dashboard.resyncPeriod: must be positive, got 0s")
+	assert.ErrorContains(t, err, "defaults.dashboard.contentCacheDuration: must not be negative, got -1m0s")
+	assert.ErrorContains(t, err, "defaults.dashboard.envs[0].name: must be set")
+	assert.ErrorContains(t, err, `defaults.datasource.allowCrossNamespaceImport: must not be true with "tenant" isolation mode`)
+	assert.ErrorContains(t, err, `defaults.datasource.labels: "app.kubernetes.io/managed-by-operator" is reserved for the converter`)
+	assert.ErrorContains(t, err, `defaults.folder.annotations: "grafana-converter.qubership.org/hash" is reserved for the converter`)
+}
+
+func TestDashboardDefaults(t *testing.T) {
+	controller := &ConverterController{
+		log:               logr.Discard(),
+		v1alpha1clientset: v1alpha1fake.NewSimpleClientset(),
+		v1beta1clientset:  v1beta1fake.NewSimpleClientset(),
+	}
+	conf := ConverterConfig{
+		Enable:                  true,
+		EnabledGrafanaConverter: EnabledGrafanaConverter{Dashboard: true},
+		Defaults: Defaults{Dashboard: DashboardDefaults{
+			KindDefaults: KindDefaults{
+				ResyncPeriod:              &metav1.Duration{Duration: 10 * time.Minute},
+				AllowCrossNamespaceImport: ptr.To(false),
+				Labels:                    map[string]string{"team": "observability", "app": "default"},
+				Annotations:               map[string]string{"example.com/owner": "observability"},
+			},
+			ContentCacheDuration: &metav1.Duration{Duration: time.Hour},
+			Envs:                 []v1beta1.GrafanaDashboardEnv{{Name: "CLUSTER", Value: "prod"}},
+		}},
+	}
+	controller.setConfig(conf)
+	informers, err := controller.newGrafanaInformers(conf)
+	require.NoError(t, err)
+	controller.grafanas = informers
+	dashboard := func(labels map[string]string) *v1alpha1.GrafanaDashboard {
+		return &v1alpha1.GrafanaDashboard{ObjectMeta: metav1.ObjectMeta{
+			Name: "sample-dashboard", Namespace: "product-a", Labels: labels,
+		}}
+	}
+
+	source := dashboard(map[string]string{"app": "product-a"})
+	converted := controller.convertGrafanaDashboard(source, controller.place(v1alpha1.GrafanaDashboardKind, source))
+	assert.Equal(t, "10m0s", converted.Spec.ResyncPeriod)
+	assert.Equal(t, ptr.To(false), converted.Spec.AllowCrossNamespaceImport)
+	assert.Equal(t, "product-a", converted.Labels["app"], "labels of sources take precedence")
+	assert.Equal(t, "observability", converted.Labels["team"])
+	assert.Equal(t, "observability", converted.Annotations["example.com/owner"])
+	assert.Equal(t, []v1beta1.GrafanaDashboardEnv{{Name: "CLUSTER", Value: "prod"}}, converted.Spec.Envs)
+	assert.Equal(t, metav1.Duration{Duration: time.Hour}, converted.Spec.ContentCacheDuration)
+
+	require.NoError(t, informers.legacy[0].Integreatly().V1alpha1().Grafanas().Informer().GetStore().Add(&v1alpha1.Grafana{
+		ObjectMeta: metav1.ObjectMeta{Name: "grafana", Namespace: "monitoring"},
+		Spec: v1alpha1.GrafanaSpec{
+			DashboardLabelSelector:        []*metav1.LabelSelector{{MatchLabels: map[string]string{"app": "product-a"}}},
+			DashboardContentCacheDuration: metav1.Duration{Duration: 5 * time.Minute},
+		},
+	}))
+	source = dashboard(map[string]string{"app": "product-a"})
+	converted = controller.convertGrafanaDashboard(source, controller.place(v1alpha1.GrafanaDashboardKind, source))
+	assert.Equal(t, metav1.Duration{Duration: 5 * time.Minute}, converted.Spec.ContentCacheDuration,
+		"legacy Grafanas importing the dashboard take precedence over the default")
+
+	source = dashboard(map[string]string{"app": "product-b"})
+	converted = controller.convertGrafanaDashboard(source, controller.place(v1alpha1.GrafanaDashboardKind, source))
+	assert.Equal(t, metav1.Duration{Duration: time.Hour}, converted.Spec.ContentCacheDuration)
+
+	source = dashboard(map[string]string{"app": "product-a"})
+	source.Spec.ContentCacheDuration = &metav1.Duration{Duration: time.Minute}
+	converted = controller.convertGrafanaDashboard(source, controller.place(v1alpha1.GrafanaDashboardKind, source))
+	assert.Equal(t, metav1.Duration{Duration: time.Minute}, converted.Spec.ContentCacheDuration)
+}
@@ -0,0 +1,141 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DeletionPolicy defines what happens with converted objects when their v1alpha1 source is deleted
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete - the converter deletes converted objects together with the source
+	DeletionPolicyDelete DeletionPolicy = "delete"
+	// DeletionPolicyOrphan - converted objects are kept in place, it is the default policy
+	DeletionPolicyOrphan DeletionPolicy = "orphan"
+	// DeletionPolicyOwnerReference - converted objects are owned by the source and removed by Kubernetes garbage collection
+	DeletionPolicyOwnerReference DeletionPolicy = "ownerReference"
+)
+
+func (p DeletionPolicy) validate() error {
+	switch p {
+	case "", DeletionPolicyDelete, DeletionPolicyOrphan, DeletionPolicyOwnerReference:
+		return nil
+	}
+	return fmt.Errorf("unknown deletion policy %q, must be one of: %q, %q, %q", p, DeletionPolicyDelete, DeletionPolicyOrphan, DeletionPolicyOwnerReference)
+}
+
+func (p DeletionPolicy) orDefault() DeletionPolicy {
+	if p == "" {
+		return DeletionPolicyOrphan
+	}
+	return p
+}
+
+func (p DeletionPolicies) validate() error {
+	var errs []error
+	for _, kind := range []struct {
+		name   string
+		policy DeletionPolicy
+	}{
+		{"dashboard", p.Dashboard},
+		{"datasource", p.Datasource},
+		{"folder", p.Folder},
+		{"notification", p.NotificationChannel},
+	} {
+		if err := kind.policy.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("deletionPolicy.%s: %w", kind.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sourceFromTombstone unwraps objects which were deleted while the informer was disconnected from the API server
+func sourceFromTombstone(obj interface{}) interface{} {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		return tombstone.Obj
+	}
+	return obj
+}
+
+// sourceExists checks in informer caches whether the v1alpha1 object of the kind is still present
+func (c *ConverterController) sourceExists(kind, namespace, name string) (bool, error) {
+	var err error
+	switch kind {
+	case v1alpha1.GrafanaDashboardKind:
+		_, err = c.getGrafanaDashboard(namespace, name)
+	case v1alpha1.GrafanaDataSourceKind:
+		_, err = c.getGrafanaDatasource(namespace, name)
+	case v1alpha1.GrafanaFolderKind:
+		_, err = c.getGrafanaFolder(namespace, name)
+	case v1alpha1.GrafanaNotificationChannelKind:
+		_, err = c.getGrafanaNotificationChannel(namespace, name)
+	default:
+		return false, fmt.Errorf("unknown kind %q", kind)
+	}
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// convertedSourceExists checks whether the v1alpha1 source with the name of the converted object is still present.
+// Sources in namespaces which are not watched are not in informer caches, so they are taken for existing ones,
+// the same applies to sources which do not match the source selector of the kind.
+func (c *ConverterController) convertedSourceExists(ctx context.Context, kind string, converted metav1.Object, sourceName string) (bool, error) {
+	namespace := sourceNamespaceOf(converted)
+	if namespace != converted.GetNamespace() && !c.watchesNamespace(namespace) {
+		return true, nil
+	}
+	exists, err := c.sourceExists(kind, namespace, sourceName)
+	if err != nil || exists {
+		return exists, err
+	}
+	return c.deselectedBySourceSelector(ctx, kind, namespace, sourceName)
+}
+
+// sweepOrphans finds converted objects whose v1alpha1 source vanished while the converter was not running
+// and applies the deletion policy of their kind to them
+func (c *ConverterController) sweepOrphans(ctx context.Context) {
+	c.sweepOrphansIn(ctx, append(c.watchedNamespaces(), c.sweptTargetNamespaces()...))
+}
+
+// sweepOrphansIn sweeps converted objects of vanished sources in the namespaces, converted objects
+// in target namespaces are mapped back to their sources by the source namespace annotation
+func (c *ConverterController) sweepOrphansIn(ctx context.Context, namespaces []string) {
+	conf := c.config()
+	sweeps := []struct {
+		enabled bool
+		kind    string
+		policy  DeletionPolicy
+		sweep   func(ctx context.Context, namespace string) error
+	}{
+		{conf.Dashboard, v1alpha1.GrafanaDashboardKind, conf.DeletionPolicy.Dashboard, c.sweepGrafanaDashboards},
+		{conf.Datasource, v1alpha1.GrafanaDataSourceKind, conf.DeletionPolicy.Datasource, c.sweepGrafanaDatasources},
+		{conf.Folder, v1alpha1.GrafanaFolderKind, conf.DeletionPolicy.Folder, c.sweepGrafanaFolders},
+		{conf.NotificationChannel, v1alpha1.GrafanaNotificationChannelKind, conf.DeletionPolicy.NotificationChannel, c.sweepGrafanaNotificationChannels},
+	}
+	for _, s := range sweeps {
+		// orphaned objects are kept on purpose, objects with an owner reference to a vanished source
+		// are collected by Kubernetes, but objects converted before the policy was set have to be removed here
+		if !s.enabled || c.deletionPolicy(s.policy) == DeletionPolicyOrphan {
+			continue
+		}
+		for _, ns := range namespaces {
+			if err := s.sweep(ctx, ns); err != nil {
+				c.log.Error(err, "cannot sweep orphaned converted objects", "kind", s.kind, "ns", ns)
+			}
+		}
+	}
+}
+
+// managedByOperatorSelector selects objects created by the converter
+var managedByOperatorSelector = metav1.ListOptions{
+	LabelSelector: managedByOperatorLabelKey + "=" + managedByOperatorLabelValue,
+}
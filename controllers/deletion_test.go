@@ -0,0 +1,177 @@
+package controllers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	v1alpha1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeleteGrafanaDashboardFollowsDeletionPolicy(t *testing.T) {
+	for _, tc := range []struct {
+		policy  DeletionPolicy
+		deleted bool
+	}{
+		{policy: "", deleted: false},
+		{policy: DeletionPolicyOrphan, deleted: false},
+		{policy: DeletionPolicyOwnerReference, deleted: false},
+		{policy: DeletionPolicyDelete, deleted: true},
+	} {
+		t.Run(string(tc.policy), func(t *testing.T) {
+			existing := &v1beta1.GrafanaDashboard{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "sample-dashboard",
+					Namespace: "product-a",
+					Labels:    map[string]string{converterManagedLabel: converterManagedValue},
+				},
+			}
+			client := newFakeV1beta1Clientset(existing)
+			controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
+			controller.setConfig(ConverterConfig{DeletionPolicy: DeletionPolicies{Dashboard: tc.policy}})
+
+			require.NoError(t, controller.syncGrafanaDashboard(context.Background(), "product-a/sample-dashboard"))
+
+			_, err := client.GrafanaIntegreatlyV1beta1().GrafanaDashboards(existing.Namespace).Get(
+				context.Background(), existing.Name, metav1.GetOptions{},
+			)
+			assert.Equal(t, tc.deleted, apierrs.IsNotFound(err))
+		})
+	}
+}
+
+func TestDeleteGrafanaFolderKeepsUnmarkedCollision(t *testing.T) {
+	existing := &v1beta1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"},
+	}
+	client := newFakeV1beta1Clientset(existing)
+	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
+	controller.setConfig(ConverterConfig{DeletionPolicy: DeletionPolicies{Folder: DeletionPolicyDelete}})
+
+	require.NoError(t, controller.syncGrafanaFolder(context.Background(), "product-a/sample-folder"))
+
+	_, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders(existing.Namespace).Get(
+		context.Background(), existing.Name, metav1.GetOptions{},
+	)
+	require.NoError(t, err)
+}
+
+func TestReconcileGrafanaDatasourceDeletesRemovedEntries(t *testing.T) {
+	managed := map[string]string{converterManagedLabel: converterManagedValue}
+	kept := &v1beta1.GrafanaDatasource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "product-a-prometheus",
+			Namespace:   "product-a",
+			Labels:      managed,
+			Annotations: map[string]string{sourceNameAnnotationKey: "sample"},
+		},
+	}
+	removed := &v1beta1.GrafanaDatasource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "product-a-loki",
+			Namespace:   "product-a",
+			Labels:      managed,
+			Annotations: map[string]string{sourceNameAnnotationKey: "sample"},
+		},
+	}
+	foreign := &v1beta1.GrafanaDatasource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "product-a-jaeger",
+			Namespace:   "product-a",
+			Labels:      managed,
+			Annotations: map[string]string{sourceNameAnnotationKey: "other"},
+		},
+	}
+	client := newFakeV1beta1Clientset(kept, removed, foreign)
+	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
+	controller.setConfig(ConverterConfig{DeletionPolicy: DeletionPolicies{Datasource: DeletionPolicyDelete}})
+	old := &v1alpha1.GrafanaDataSource{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "product-a"},
+		Spec: v1alpha1.GrafanaDataSourceSpec{
+			Datasources: []v1alpha1.GrafanaDataSourceFields{{Name: "Prometheus"}, {Name: "Loki"}},
+		},
+	}
+	updated := old.DeepCopy()
+	updated.Spec.Datasources = updated.Spec.Datasources[:1]
+
+	require.NoError(t, controller.reconcileGrafanaDatasource(context.Background(), logr.Discard(), updated, controller.place(v1alpha1.GrafanaDataSourceKind, updated), false))
+
+	actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaDatasources("product-a").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	var names []string
+	for _, ds := range actual.Items {
+		names = append(names, ds.Name)
+	}
+	assert.ElementsMatch(t, []string{kept.Name, foreign.Name}, names)
+}
+
+func TestConvertGrafanaDashboardSetsSourceOwnerReference(t *testing.T) {
+	source := &v1alpha1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-dashboard", Namespace: "product-a", UID: "source-uid"},
+	}
+	controller := &ConverterController{log: logr.Discard()}
+	controller.setConfig(ConverterConfig{DeletionPolicy: DeletionPolicies{Dashboard: DeletionPolicyOwnerReference}})
+
+	converted := controller.convertGrafanaDashboard(source, controller.place(v1alpha1.GrafanaDashboardKind, source))
+
+	require.Len(t, converted.OwnerReferences, 1)
+	assert.Equal(t, v1alpha1.GroupVersion.String(), converted.OwnerReferences[0].APIVersion)
+	assert.Equal(t, v1alpha1.GrafanaDashboardKind, converted.OwnerReferences[0].Kind)
+	assert.Equal(t, source.ObjectMeta.UID, converted.OwnerReferences[0].UID)
+	assert.Equal(t, source.Name, converted.Annotations[sourceNameAnnotationKey])
+}
+
+func TestSweepOrphansDeletesConvertedObjectsOfVanishedSources(t *testing.T) {
+	managed := map[string]string{converterManagedLabel: converterManagedValue}
+	orphan := &v1beta1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: "orphan", Namespace: "product-a", Labels: managed},
+	}
+	alive := &v1beta1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: "alive", Namespace: "product-a", Labels: managed},
+	}
+	foreign := &v1beta1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: "foreign", Namespace: "product-a"},
+	}
+	client := newFakeV1beta1Clientset(orphan, alive, foreign)
+	informerFactory := v1alpha1informers.NewSharedInformerFactory(v1alpha1fake.NewSimpleClientset(), 0)
+	require.NoError(t, informerFactory.Integreatly().V1alpha1().GrafanaDashboards().Informer().GetStore().Add(
+		&v1alpha1.GrafanaDashboard{ObjectMeta: metav1.ObjectMeta{Name: alive.Name, Namespace: alive.Namespace}},
+	))
+	controller := &ConverterController{
+		log:                     logr.Discard(),
+		v1beta1clientset:        client,
+		v1alpha1InformerFactory: []v1alpha1informers.SharedInformerFactory{informerFactory},
+	}
+	controller.setConfig(ConverterConfig{
+		EnabledGrafanaConverter: EnabledGrafanaConverter{Dashboard: true},
+		DeletionPolicy:          DeletionPolicies{Dashboard: DeletionPolicyDelete},
+	})
+
+	controller.sweepOrphans(context.Background())
+
+	actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaDashboards("product-a").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	var names []string
+	for _, d := range actual.Items {
+		names = append(names, d.Name)
+	}
+	assert.ElementsMatch(t, []string{alive.Name, foreign.Name}, names)
+}
+
+func TestReadConfigRejectsUnknownDeletionPolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "parameters.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("enable: true\ndeletionPolicy:\n  dashboard: remove\n"), 0o600))
+
+	_, err := ReadConfig(path)
+
+	assert.ErrorContains(t, err, "deletionPolicy.dashboard")
+}
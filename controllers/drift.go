@@ -0,0 +1,242 @@
+package controllers
+
+import (
+	"fmt"
+	"slices"
+
+	v1beta1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/informers/externalversions"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DriftPolicy defines what happens when a converted object is changed or deleted by somebody else
+type DriftPolicy string
+
+const (
+	// DriftPolicyIgnore - converted objects are not watched, it is the default policy of all strategies except mirror
+	DriftPolicyIgnore DriftPolicy = "ignore"
+	// DriftPolicyReport - the converter logs drifted converted objects and leaves them as is
+	DriftPolicyReport DriftPolicy = "report"
+	// DriftPolicyRestore - the converter restores drifted converted objects, it is the default policy of the mirror strategy
+	DriftPolicyRestore DriftPolicy = "restore"
+)
+
+func (p DriftPolicy) validate() error {
+	switch p {
+	case "", DriftPolicyIgnore, DriftPolicyReport, DriftPolicyRestore:
+		return nil
+	}
+	return fmt.Errorf("unknown drift policy %q, must be one of: %q, %q, %q", p, DriftPolicyIgnore, DriftPolicyReport, DriftPolicyRestore)
+}
+
+// driftPolicy returns the configured drift policy or the default one of the sync strategy
+func (c *ConverterController) driftPolicy() DriftPolicy {
+	return c.config().driftPolicy()
+}
+
+// driftPolicy returns the configured drift policy or the default one of the sync strategy
+func (c ConverterConfig) driftPolicy() DriftPolicy {
+	if c.DriftPolicy != "" {
+		return c.DriftPolicy
+	}
+	if c.Strategy.enforcesState() {
+		return DriftPolicyRestore
+	}
+	return DriftPolicyIgnore
+}
+
+// reportsDrift reports whether the key has to be only checked for drift without changing converted objects
+func (c *ConverterController) reportsDrift(kind, key string) bool {
+	return c.driftPolicy() == DriftPolicyReport && c.queue(kind).isDriftOnly(key)
+}
+
+// driftHandler enqueues v1alpha1 sources of converted objects which were changed or deleted,
+// sourceNameRequired is set for kinds whose source can not be derived from the converted object name
+func driftHandler(queue *kindQueue, sourceNameRequired bool) cache.ResourceEventHandler {
+	enqueueSource := func(obj interface{}) {
+		converted, ok := sourceFromTombstone(obj).(metav1.Object)
+		if !ok {
+			return
+		}
+		sourceName, ok := sourceNameOf(converted)
+		if !ok {
+			if sourceNameRequired {
+				return
+			}
+			sourceName = converted.GetName()
+		}
+		queue.enqueueDrift(sourceNamespaceOf(converted) + "/" + sourceName)
+	}
+	return cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(old, new interface{}) {
+			oldObject, oldOk := old.(metav1.Object)
+			newObject, newOk := new.(metav1.Object)
+			// status updates and resyncs keep the spec generation and metadata
+			if oldOk && newOk && onlyStatusChanged(oldObject, newObject) {
+				return
+			}
+			enqueueSource(new)
+		},
+		DeleteFunc: enqueueSource,
+	}
+}
+
+// watchConvertedObjects creates informers of v1beta1 objects in the namespace managed by the converter which enqueue
+// their sources to the queues when they drift, synced collects by kind whether handlers delivered the initial state
+func (c *ConverterController) watchConvertedObjects(namespace string, queues map[string]*kindQueue, synced map[string][]cache.InformerSynced) (v1beta1informers.SharedInformerFactory, error) {
+	managedOnly := v1beta1informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+		options.LabelSelector = managedByOperatorSelector.LabelSelector
+	})
+	informerFactory := v1beta1informers.NewSharedInformerFactoryWithOptions(c.v1beta1clientset, 0, managedOnly, v1beta1informers.WithNamespace(namespace))
+
+	informers := informerFactory.Observability().V1beta1()
+	// informers are created only for converted kinds
+	for kind, informer := range map[string]func() cache.SharedIndexInformer{
+		v1alpha1.GrafanaDashboardKind:           informers.GrafanaDashboards().Informer,
+		v1alpha1.GrafanaDataSourceKind:          informers.GrafanaDatasources().Informer,
+		v1alpha1.GrafanaFolderKind:              informers.GrafanaFolders().Informer,
+		v1alpha1.GrafanaNotificationChannelKind: informers.GrafanaContactPoints().Informer,
+	} {
+		queue, ok := queues[kind]
+		if !ok {
+			continue
+		}
+		registration, err := informer().AddEventHandler(driftHandler(queue, kind == v1alpha1.GrafanaDataSourceKind))
+		if err != nil {
+			return nil, fmt.Errorf("cannot add converted %s drift handler: %w", kind, err)
+		}
+		synced[kind] = append(synced[kind], registration.HasSynced)
+	}
+	return informerFactory, nil
+}
+
+// targetInformers are informers of converted objects in a target namespace which is not watched
+type targetInformers struct {
+	// converted is nil if drift is ignored or converted objects can not be listed
+	converted v1beta1informers.SharedInformerFactory
+	// listable is false if the converter is not allowed to list converted objects in the namespace
+	listable bool
+}
+
+// watchTargetNamespaces watches converted objects in target namespaces named in the configuration and in the ones
+// sources in informer caches are placed in, target namespaces found later are watched when objects are placed there
+func (c *ConverterController) watchTargetNamespaces(conf ConverterConfig) {
+	if slices.Contains(c.watchedNamespaces(), metav1.NamespaceAll) {
+		return
+	}
+	for _, ns := range configuredTargetNamespaces(conf) {
+		c.watchTargetNamespace(ns)
+	}
+	c.mu.RLock()
+	queues, factories := c.queues, c.v1alpha1InformerFactory
+	c.mu.RUnlock()
+	for _, kind := range c.convertedKinds(conf) {
+		if _, ok := queues[kind.kind]; !ok {
+			continue
+		}
+		for _, factory := range factories {
+			for _, obj := range kind.informer(factory).GetStore().List() {
+				if source, ok := obj.(metav1.Object); ok {
+					// placing the source watches its target namespace
+					c.place(kind.kind, source)
+				}
+			}
+		}
+	}
+}
+
+// watchTargetNamespace watches converted objects in the target namespace unless its sources are watched, so they
+// are restored when they drift and swept when their sources vanish, orphans in the namespace are swept right away.
+// Namespaces whose converted objects can not be listed are remembered and left alone.
+func (c *ConverterController) watchTargetNamespace(namespace string) {
+	c.mu.RLock()
+	_, known := c.targetNamespaces[namespace]
+	informersCtx, conf, queues := c.informersCtx, c.informersConf, c.queues
+	c.mu.RUnlock()
+	if known || informersCtx == nil || informersCtx.Err() != nil || len(queues) == 0 || c.watchesNamespace(namespace) {
+		return
+	}
+
+	listable, err := c.convertedObjectsListable(namespace, queues)
+	if err != nil {
+		c.log.Error(err, "cannot watch converted objects in target namespace", "ns", namespace)
+		return
+	}
+	informers := &targetInformers{listable: listable}
+	if listable && conf.driftPolicy() != DriftPolicyIgnore {
+		// handlers of target namespaces are not a part of the initial state of queues
+		if informers.converted, err = c.watchConvertedObjects(namespace, queues, map[string][]cache.InformerSynced{}); err != nil {
+			c.log.Error(err, "cannot watch converted objects in target namespace", "ns", namespace)
+			return
+		}
+	}
+
+	c.mu.Lock()
+	if _, known := c.targetNamespaces[namespace]; known || c.informersCtx != informersCtx {
+		c.mu.Unlock()
+		return
+	}
+	c.targetNamespaces[namespace] = informers
+	if informers.converted != nil {
+		c.log.Info("watching converted objects in target namespace", "ns", namespace)
+		informers.converted.Start(informersCtx.Done())
+		c.refreshInformers()
+	}
+	c.mu.Unlock()
+
+	if listable {
+		c.sweepOrphansIn(informersCtx, []string{namespace})
+	}
+}
+
+// convertedObjectsListable reports whether converted objects of the kinds of the queues can be listed in the namespace
+func (c *ConverterController) convertedObjectsListable(namespace string, queues map[string]*kindQueue) (bool, error) {
+	client := c.v1beta1clientset.GrafanaIntegreatlyV1beta1()
+	options := metav1.ListOptions{LabelSelector: managedByOperatorSelector.LabelSelector, Limit: 1}
+	for kind, list := range map[string]func() error{
+		v1alpha1.GrafanaDashboardKind: func() error {
+			_, err := client.GrafanaDashboards(namespace).List(c.ctx, options)
+			return err
+		},
+		v1alpha1.GrafanaDataSourceKind: func() error {
+			_, err := client.GrafanaDatasources(namespace).List(c.ctx, options)
+			return err
+		},
+		v1alpha1.GrafanaFolderKind: func() error {
+			_, err := client.GrafanaFolders(namespace).List(c.ctx, options)
+			return err
+		},
+		v1alpha1.GrafanaNotificationChannelKind: func() error {
+			_, err := client.GrafanaContactPoints(namespace).List(c.ctx, options)
+			return err
+		},
+	} {
+		if _, ok := queues[kind]; !ok {
+			continue
+		}
+		if err := list(); err != nil {
+			if apierrors.IsForbidden(err) {
+				c.log.Info("converted objects can not be listed in target namespace, they are not watched and swept", "ns", namespace, "reason", err.Error())
+				return false, nil
+			}
+			return false, fmt.Errorf("cannot list converted %s: %w", kind, err)
+		}
+	}
+	return true, nil
+}
+
+// sweptTargetNamespaces returns target namespaces whose converted objects can be listed
+func (c *ConverterController) sweptTargetNamespaces() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var namespaces []string
+	for ns, informers := range c.targetNamespaces {
+		if informers.listable {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	slices.Sort(namespaces)
+	return namespaces
+}
@@ -0,0 +1,238 @@
+package controllers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	v1alpha1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientfeatures "k8s.io/client-go/features"
+	clientfeaturestesting "k8s.io/client-go/features/testing"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestDriftHandlerEnqueuesSourceOfChangedObject(t *testing.T) {
+	converted := &v1beta1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "converted-dashboard",
+			Namespace:   "product-a",
+			Generation:  1,
+			Annotations: map[string]string{sourceNameAnnotationKey: "sample-dashboard"},
+		},
+	}
+	statusUpdated := converted.DeepCopy()
+	statusUpdated.Status.Hash = "updated"
+	edited := converted.DeepCopy()
+	edited.Generation = 2
+	queue := newKindQueue(v1alpha1.GrafanaDashboardKind, 1, SyncStrategySync, nil, logr.Discard())
+	defer queue.shutDown()
+	handler := driftHandler(queue, false)
+
+	handler.OnUpdate(converted, statusUpdated)
+	assert.Equal(t, 0, queue.queue.Len())
+
+	handler.OnUpdate(converted, edited)
+	require.Equal(t, 1, queue.queue.Len())
+	key, _ := queue.queue.Get()
+	assert.Equal(t, "product-a/sample-dashboard", key)
+	queue.queue.Done(key)
+
+	handler.OnDelete(edited)
+	assert.Equal(t, 1, queue.queue.Len())
+}
+
+func TestDriftHandlerSkipsDatasourceWithoutSourceName(t *testing.T) {
+	queue := newKindQueue(v1alpha1.GrafanaDataSourceKind, 1, SyncStrategySync, nil, logr.Discard())
+	defer queue.shutDown()
+
+	driftHandler(queue, true).OnDelete(&v1beta1.GrafanaDatasource{
+		ObjectMeta: metav1.ObjectMeta{Name: "product-a-prometheus", Namespace: "product-a"},
+	})
+
+	assert.Equal(t, 0, queue.queue.Len())
+}
+
+func TestDriftPolicyRestoresOrReportsEditedFolder(t *testing.T) {
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"},
+		Spec:       v1alpha1.GrafanaFolderSpec{FolderName: "converted"},
+	}
+	for _, tc := range []struct {
+		policy   DriftPolicy
+		expected string
+	}{
+		{policy: DriftPolicyReport, expected: "edited"},
+		{policy: DriftPolicyRestore, expected: "converted"},
+	} {
+		t.Run(string(tc.policy), func(t *testing.T) {
+			edited := &v1beta1.GrafanaFolder{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      source.Name,
+					Namespace: source.Namespace,
+					Labels:    map[string]string{converterManagedLabel: converterManagedValue},
+				},
+				Spec: v1beta1.GrafanaFolderSpec{Title: "edited"},
+			}
+			client := newFakeV1beta1Clientset(edited)
+			sourceClient := v1alpha1fake.NewSimpleClientset(source)
+			informerFactory := v1alpha1informers.NewSharedInformerFactory(sourceClient, 0)
+			require.NoError(t, informerFactory.Integreatly().V1alpha1().GrafanaFolders().Informer().GetStore().Add(source))
+			controller := &ConverterController{
+				log:                     logr.Discard(),
+				v1alpha1clientset:       sourceClient,
+				v1beta1clientset:        client,
+				v1alpha1InformerFactory: []v1alpha1informers.SharedInformerFactory{informerFactory},
+				queues:                  map[string]*kindQueue{},
+			}
+			controller.setConfig(ConverterConfig{DriftPolicy: tc.policy})
+			queue := controller.addQueue(v1alpha1.GrafanaFolderKind, 1, controller.syncGrafanaFolder)
+			defer queue.shutDown()
+
+			queue.enqueueDrift("product-a/sample-folder")
+			require.True(t, queue.processNextItem(context.Background()))
+
+			actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders(source.Namespace).Get(
+				context.Background(), source.Name, metav1.GetOptions{},
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual.Spec.Title)
+		})
+	}
+}
+
+func TestReportedDriftDoesNotRecreateDeletedContactPoint(t *testing.T) {
+	source := &v1alpha1.GrafanaNotificationChannel{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-contact-point", Namespace: "product-a"},
+		Spec:       v1alpha1.GrafanaNotificationChannelSpec{Json: `{"name":"sample","type":"email","settings":{}}`},
+	}
+	client := newFakeV1beta1Clientset()
+	sourceClient := v1alpha1fake.NewSimpleClientset(source)
+	informerFactory := v1alpha1informers.NewSharedInformerFactory(sourceClient, 0)
+	require.NoError(t, informerFactory.Integreatly().V1alpha1().GrafanaNotificationChannels().Informer().GetStore().Add(source))
+	controller := &ConverterController{
+		log:                     logr.Discard(),
+		v1alpha1clientset:       sourceClient,
+		v1beta1clientset:        client,
+		v1alpha1InformerFactory: []v1alpha1informers.SharedInformerFactory{informerFactory},
+		queues:                  map[string]*kindQueue{},
+	}
+	controller.setConfig(ConverterConfig{DriftPolicy: DriftPolicyReport})
+	queue := controller.addQueue(v1alpha1.GrafanaNotificationChannelKind, 1, controller.syncGrafanaNotificationChannel)
+	defer queue.shutDown()
+
+	queue.enqueueDrift("product-a/sample-contact-point")
+	require.True(t, queue.processNextItem(context.Background()))
+
+	_, err := client.GrafanaIntegreatlyV1beta1().GrafanaContactPoints("product-a").Get(
+		context.Background(), "sample-contact-point", metav1.GetOptions{},
+	)
+	assert.True(t, apierrs.IsNotFound(err))
+}
+
+func TestDriftPolicyDefaultsToStrategy(t *testing.T) {
+	for _, tc := range []struct {
+		strategy SyncStrategy
+		policy   DriftPolicy
+		expected DriftPolicy
+	}{
+		{strategy: "", policy: "", expected: DriftPolicyIgnore},
+		{strategy: SyncStrategyMirror, policy: "", expected: DriftPolicyRestore},
+		{strategy: SyncStrategyMirror, policy: DriftPolicyReport, expected: DriftPolicyReport},
+		{strategy: SyncStrategySync, policy: DriftPolicyRestore, expected: DriftPolicyRestore},
+	} {
+		controller := &ConverterController{}
+		controller.setConfig(ConverterConfig{Strategy: tc.strategy, DriftPolicy: tc.policy})
+
+		assert.Equal(t, tc.expected, controller.driftPolicy(), "strategy %q, policy %q", tc.strategy, tc.policy)
+	}
+}
+
+func TestReadConfigRejectsUnknownDriftPolicy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "parameters.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("enable: true\ndriftPolicy: fix\n"), 0o600))
+
+	_, err := ReadConfig(path)
+
+	assert.ErrorContains(t, err, "driftPolicy")
+}
+
+func TestConvertedObjectsInTargetNamespacesAreWatchedAndSwept(t *testing.T) {
+	// informers of converted objects do not fall back to lists and watches of the fake clientset
+	clientfeaturestesting.SetFeatureDuringTest(t, clientfeatures.WatchListClient, false)
+	t.Setenv(WatchNamespaceSelectorEnvVar, "grafana-converter: enabled")
+	kubeClient := kubefake.NewClientset(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "product-a", Labels: map[string]string{"grafana-converter": "enabled"}},
+	})
+	sourceClient := v1alpha1fake.NewSimpleClientset(&v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"},
+		Spec:       v1alpha1.GrafanaFolderSpec{FolderName: "converted"},
+	})
+	// the source of the orphan vanished while the converter was not running
+	client := newFakeV1beta1Clientset(&v1beta1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "orphan",
+			Namespace: "product-a-grafana",
+			Labels:    map[string]string{converterManagedLabel: converterManagedValue},
+			Annotations: map[string]string{
+				sourceNamespaceAnnotationKey: "product-a",
+				sourceNameAnnotationKey:      "vanished",
+			},
+		},
+	})
+	path := filepath.Join(t.TempDir(), "parameters.yaml")
+	writeConfig(t, path, `enable: true
+folder: true
+driftPolicy: restore
+deletionPolicy:
+  folder: delete
+targetNamespace:
+  namespace: "{{ .Namespace }}-grafana"
+`)
+	controller, err := NewGrafanaConverterController(context.Background(), path, sourceClient, client, nil, kubeClient, nil, 0, logr.Discard())
+	require.NoError(t, err)
+	folders := client.GrafanaIntegreatlyV1beta1().GrafanaFolders("product-a-grafana")
+	title := func() string {
+		folder, err := folders.Get(context.Background(), "sample-folder", metav1.GetOptions{})
+		if err != nil {
+			return ""
+		}
+		return folder.Spec.Title
+	}
+
+	startController(t, controller)
+	require.Eventually(t, func() bool { return title() == "converted" }, 5*time.Second, 10*time.Millisecond)
+	_, err = folders.Get(context.Background(), "orphan", metav1.GetOptions{})
+	assert.True(t, apierrs.IsNotFound(err), "the orphan in the target namespace is swept")
+
+	// edits are watched once the informer of the target namespace delivered the converted object
+	controller.mu.RLock()
+	target := controller.targetNamespaces["product-a-grafana"]
+	controller.mu.RUnlock()
+	require.NotNil(t, target)
+	require.NotNil(t, target.converted)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for informer, synced := range target.converted.WaitForCacheSync(ctx.Done()) {
+		require.True(t, synced, "%v informer is synced", informer)
+	}
+
+	folder, err := folders.Get(context.Background(), "sample-folder", metav1.GetOptions{})
+	require.NoError(t, err)
+	folder.Spec.Title = "edited"
+	folder.Generation++
+	_, err = folders.Update(context.Background(), folder, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return title() == "converted" }, 5*time.Second, 10*time.Millisecond,
+		"the drift of the object in the target namespace is restored")
+}
@@ -0,0 +1,147 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Reasons of events recorded on v1alpha1 objects
+const (
+	eventReasonConverted        = "Converted"
+	eventReasonConversionFailed = "ConversionFailed"
+	eventReasonNotManaged       = "NotManaged"
+	eventReasonLossyConversion  = "LossyConversion"
+	eventReasonOrphaned         = "Orphaned"
+	eventReasonUnplaceable      = "Unplaceable"
+
+	eventActionConvert = "Convert"
+	eventActionDelete  = "Delete"
+)
+
+// recordEvent records the event on the v1alpha1 object, related is the converted object if there is one
+func (c *ConverterController) recordEvent(regarding, related runtime.Object, eventtype, reason, action, note string, args ...interface{}) {
+	if c.recorder == nil {
+		return
+	}
+	c.recorder.Eventf(regarding, related, eventtype, reason, action, note, args...)
+}
+
+// recordConverted records that the v1alpha1 object has been applied as the converted object,
+// dropped are fields of the source which the converted kind can not express
+func (c *ConverterController) recordConverted(src, converted runtime.Object, kind, namespace, name string, dropped []string) {
+	c.recordEvent(src, converted, corev1.EventTypeNormal, eventReasonConverted, eventActionConvert,
+		"converted to %s %s/%s", kind, namespace, name)
+	if len(dropped) > 0 {
+		c.recordEvent(src, converted, corev1.EventTypeWarning, eventReasonLossyConversion, eventActionConvert,
+			"%s are not supported by %s %s/%s and are dropped", strings.Join(dropped, ", "), kind, namespace, name)
+	}
+}
+
+// recordNotManaged records that the converted object exists and the converter is not allowed to change it
+func (c *ConverterController) recordNotManaged(src, existing runtime.Object, kind, namespace, name string) {
+	c.recordEvent(src, existing, corev1.EventTypeWarning, eventReasonNotManaged, eventActionConvert,
+		"%s %s/%s already exists and is not managed by the converter", kind, namespace, name)
+}
+
+// recordConversionFailed records the conversion error on the v1alpha1 object,
+// conflicts with objects which are not managed by the converter are already recorded as NotManaged
+// and objects which can not be placed with tenant isolation are recorded as Unplaceable
+func (c *ConverterController) recordConversionFailed(src runtime.Object, kind, namespace string, names []string, err error) {
+	if err == nil || isOnlyNotManaged(err) {
+		return
+	}
+	reason := eventReasonConversionFailed
+	if errors.Is(err, errUnplaceable) {
+		reason = eventReasonUnplaceable
+	}
+	c.recordEvent(src, nil, corev1.EventTypeWarning, reason, eventActionConvert,
+		"cannot convert to %s %s: %v", kind, objectNames(namespace, names), err)
+}
+
+// isOnlyNotManaged reports whether all joined errors are conflicts with objects not managed by the converter
+func isOnlyNotManaged(err error) bool {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, err := range joined.Unwrap() {
+			if !isOnlyNotManaged(err) {
+				return false
+			}
+		}
+		return true
+	}
+	return errors.Is(err, errNotManaged)
+}
+
+// recordOrphaned records on the last known state of the deleted v1alpha1 object with the key
+// that its converted objects are kept
+func (c *ConverterController) recordOrphaned(sourceKind, key, kind, namespace string, names []string) {
+	lastState, _ := c.queue(sourceKind).lastState(key)
+	src, ok := lastState.(runtime.Object)
+	if !ok {
+		return
+	}
+	c.recordEvent(src, nil, corev1.EventTypeNormal, eventReasonOrphaned, eventActionDelete,
+		"source has been deleted, %s %s are kept", kind, objectNames(namespace, names))
+}
+
+// objectNames formats names of objects in the namespace for event notes
+func objectNames(namespace string, names []string) string {
+	keys := make([]string, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, namespace+"/"+name)
+	}
+	return strings.Join(keys, ", ")
+}
+
+// droppedGrafanaDashboardFields returns fields of GrafanaDashboard v1alpha1 which GrafanaDashboard v1beta1 can not express
+func droppedGrafanaDashboardFields(src *v1alpha1.GrafanaDashboard) []string {
+	var dropped []string
+	if src.Spec.GzipConfigMapRef != nil {
+		dropped = append(dropped, "spec.gzipConfigMapRef")
+	}
+	return dropped
+}
+
+// droppedGrafanaDatasourceFields returns fields of a GrafanaDataSource v1alpha1 datasource
+// which GrafanaDatasource v1beta1 can not express
+func droppedGrafanaDatasourceFields(ds v1alpha1.GrafanaDataSourceFields) []string {
+	var dropped []string
+	if ds.Password != "" {
+		dropped = append(dropped, "spec.datasources.password")
+	}
+	if ds.BasicAuthPassword != "" {
+		dropped = append(dropped, "spec.datasources.basicAuthPassword")
+	}
+	if ds.WithCredentials {
+		dropped = append(dropped, "spec.datasources.withCredentials")
+	}
+	if ds.Version != 0 {
+		dropped = append(dropped, "spec.datasources.version")
+	}
+	return dropped
+}
+
+// droppedGrafanaNotificationChannelFields returns fields of the GrafanaNotificationChannel v1alpha1 JSON
+// which GrafanaContactPoint v1beta1 can not express
+func droppedGrafanaNotificationChannelFields(src *v1alpha1.GrafanaNotificationChannel) []string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(src.Spec.Json), &fields); err != nil {
+		return nil
+	}
+	var dropped []string
+	for field := range fields {
+		switch field {
+		case "name", "type", "disableResolveMessage", "settings":
+		default:
+			dropped = append(dropped, fmt.Sprintf("spec.json.%s", field))
+		}
+	}
+	slices.Sort(dropped)
+	return dropped
+}
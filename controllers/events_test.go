@@ -0,0 +1,164 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	v1alpha1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+)
+
+// recordedEvents drains events recorded by the fake recorder
+func recordedEvents(recorder *events.FakeRecorder) []string {
+	var recorded []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			recorded = append(recorded, event)
+		default:
+			return recorded
+		}
+	}
+}
+
+func TestReconcileGrafanaDashboardRecordsLossyConversion(t *testing.T) {
+	recorder := events.NewFakeRecorder(10)
+	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: newFakeV1beta1Clientset(), recorder: recorder}
+	source := &v1alpha1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "product-a"},
+		Spec: v1alpha1.GrafanaDashboardSpec{
+			GzipConfigMapRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "dashboards"}, Key: "sample.json.gz"},
+		},
+	}
+
+	require.NoError(t, controller.reconcileGrafanaDashboard(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDashboardKind, source), false))
+
+	assert.Equal(t, []string{
+		"Normal Converted converted to GrafanaDashboard product-a/sample",
+		"Warning LossyConversion spec.gzipConfigMapRef are not supported by GrafanaDashboard product-a/sample and are dropped",
+	}, recordedEvents(recorder))
+}
+
+func TestReconcileGrafanaDatasourceRecordsLossyConversionWithNamePrefix(t *testing.T) {
+	recorder := events.NewFakeRecorder(10)
+	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: newFakeV1beta1Clientset(), recorder: recorder}
+	controller.setConfig(ConverterConfig{TargetNamespace: TargetNamespace{Namespace: "monitoring", NamePrefix: "{{ .Namespace }}-"}})
+	source := &v1alpha1.GrafanaDataSource{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "product-a"},
+		Spec: v1alpha1.GrafanaDataSourceSpec{
+			Datasources: []v1alpha1.GrafanaDataSourceFields{{Name: "Loki", Type: "loki", Password: "secret"}},
+		},
+	}
+
+	require.NoError(t, controller.reconcileGrafanaDatasource(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDataSourceKind, source), false))
+
+	assert.Equal(t, []string{
+		"Normal Converted converted to GrafanaDatasource monitoring/product-a-product-a-loki",
+		"Warning LossyConversion spec.datasources.password are not supported by GrafanaDatasource monitoring/product-a-product-a-loki and are dropped",
+	}, recordedEvents(recorder), "dropped fields are found by the prefixed name")
+}
+
+func TestReconcileGrafanaFolderRecordsConvertedOnlyWhenApplyChangesObject(t *testing.T) {
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"},
+		Spec:       v1alpha1.GrafanaFolderSpec{FolderName: "converted"},
+	}
+	recorder := events.NewFakeRecorder(10)
+	client := newFakeV1beta1Clientset()
+	sourceClient := v1alpha1fake.NewSimpleClientset(source)
+	informerFactory := v1alpha1informers.NewSharedInformerFactory(sourceClient, 0)
+	require.NoError(t, informerFactory.Integreatly().V1alpha1().GrafanaFolders().Informer().GetStore().Add(source))
+	controller := &ConverterController{
+		log:                     logr.Discard(),
+		v1alpha1clientset:       sourceClient,
+		v1beta1clientset:        client,
+		v1alpha1InformerFactory: []v1alpha1informers.SharedInformerFactory{informerFactory},
+		recorder:                recorder,
+		queues:                  map[string]*kindQueue{},
+	}
+	controller.setConfig(ConverterConfig{Strategy: SyncStrategyMirror})
+	queue := controller.addQueue(v1alpha1.GrafanaFolderKind, 1, controller.syncGrafanaFolder)
+	defer queue.shutDown()
+
+	require.NoError(t, controller.reconcileGrafanaFolder(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaFolderKind, source), false))
+	assert.Equal(t, []string{"Normal Converted converted to GrafanaFolder product-a/sample-folder"}, recordedEvents(recorder))
+
+	// the drift check applies the object again, the apply keeps its resource version
+	queue.enqueueDrift("product-a/sample-folder")
+	require.True(t, queue.processNextItem(context.Background()))
+	var applies int
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "patch" {
+			applies++
+		}
+	}
+	require.Equal(t, 2, applies)
+	assert.Empty(t, recordedEvents(recorder), "applies which change nothing are not recorded")
+}
+
+func TestSyncGrafanaFolderRecordsNotManagedWithoutConversionFailure(t *testing.T) {
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"},
+	}
+	sourceClient := v1alpha1fake.NewSimpleClientset(source)
+	informerFactory := v1alpha1informers.NewSharedInformerFactory(sourceClient, 0)
+	require.NoError(t, informerFactory.Integreatly().V1alpha1().GrafanaFolders().Informer().GetStore().Add(source))
+	recorder := events.NewFakeRecorder(10)
+	controller := &ConverterController{
+		log:               logr.Discard(),
+		v1alpha1clientset: sourceClient,
+		v1beta1clientset: newFakeV1beta1Clientset(&v1beta1.GrafanaFolder{
+			ObjectMeta: metav1.ObjectMeta{Name: source.Name, Namespace: source.Namespace},
+		}),
+		v1alpha1InformerFactory: []v1alpha1informers.SharedInformerFactory{informerFactory},
+		recorder:                recorder,
+	}
+
+	err := controller.syncGrafanaFolder(context.Background(), "product-a/sample-folder")
+
+	require.ErrorIs(t, err, errNotManaged)
+	assert.Equal(t, []string{
+		"Warning NotManaged GrafanaFolder product-a/sample-folder already exists and is not managed by the converter",
+	}, recordedEvents(recorder))
+}
+
+func TestSyncGrafanaDashboardRecordsOrphanedOnDeletedSource(t *testing.T) {
+	source := &v1alpha1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "product-a", UID: "source-uid"},
+	}
+	informerFactory := v1alpha1informers.NewSharedInformerFactory(v1alpha1fake.NewSimpleClientset(), 0)
+	recorder := events.NewFakeRecorder(10)
+	controller := &ConverterController{
+		log:                     logr.Discard(),
+		v1alpha1InformerFactory: []v1alpha1informers.SharedInformerFactory{informerFactory},
+		queues:                  map[string]*kindQueue{},
+		recorder:                recorder,
+	}
+	queue := controller.addQueue(v1alpha1.GrafanaDashboardKind, 1, controller.syncGrafanaDashboard)
+	defer queue.shutDown()
+
+	queue.eventHandler().OnDelete(source)
+	require.True(t, queue.processNextItem(context.Background()))
+
+	assert.Equal(t, []string{
+		"Normal Orphaned source has been deleted, GrafanaDashboard product-a/sample are kept",
+	}, recordedEvents(recorder))
+}
+
+func TestDroppedGrafanaNotificationChannelFields(t *testing.T) {
+	source := &v1alpha1.GrafanaNotificationChannel{
+		Spec: v1alpha1.GrafanaNotificationChannelSpec{
+			Json: `{"uid":"email","name":"email","type":"email","isDefault":true,"sendReminder":false,"settings":{}}`,
+		},
+	}
+
+	assert.Equal(t, []string{"spec.json.isDefault", "spec.json.sendReminder", "spec.json.uid"}, droppedGrafanaNotificationChannelFields(source))
+}
@@ -0,0 +1,235 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	v1alpha1clientset "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned"
+	v1alpha1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions/internalinterfaces"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/google/cel-go/cel"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// filterSourceSelector and filterSourceFilter are values of the filter label of filtered sources metrics
+	filterSourceSelector = "sourceSelector"
+	filterSourceFilter   = "sourceFilter"
+
+	// sourceFilterVariable is the variable of source filter expressions which holds the v1alpha1 object
+	sourceFilterVariable = "object"
+	// sourceFilterCostLimit bounds the evaluation cost of one source filter expression
+	sourceFilterCostLimit = 1000000
+)
+
+// SourceSelectors defines per kind label selectors of v1alpha1 objects which are converted,
+// objects are selected by the API server, so informers do not cache other objects
+type SourceSelectors struct {
+	Dashboard           *metav1.LabelSelector `json:"dashboard,omitempty" yaml:"dashboard,omitempty"`
+	Datasource          *metav1.LabelSelector `json:"datasource,omitempty" yaml:"datasource,omitempty"`
+	Folder              *metav1.LabelSelector `json:"folder,omitempty" yaml:"folder,omitempty"`
+	NotificationChannel *metav1.LabelSelector `json:"notification,omitempty" yaml:"notification,omitempty"`
+}
+
+// SourceFilters defines per kind CEL expressions which v1alpha1 objects have to pass to be converted,
+// the object is available in expressions as the object variable, e.g. object.spec.name.startsWith("team-a")
+type SourceFilters struct {
+	Dashboard           string `json:"dashboard,omitempty" yaml:"dashboard,omitempty"`
+	Datasource          string `json:"datasource,omitempty" yaml:"datasource,omitempty"`
+	Folder              string `json:"folder,omitempty" yaml:"folder,omitempty"`
+	NotificationChannel string `json:"notification,omitempty" yaml:"notification,omitempty"`
+}
+
+// of returns the source selector of the kind, nil selects all objects
+func (s SourceSelectors) of(kind string) *metav1.LabelSelector {
+	switch kind {
+	case v1alpha1.GrafanaDashboardKind:
+		return s.Dashboard
+	case v1alpha1.GrafanaDataSourceKind:
+		return s.Datasource
+	case v1alpha1.GrafanaFolderKind:
+		return s.Folder
+	case v1alpha1.GrafanaNotificationChannelKind:
+		return s.NotificationChannel
+	}
+	return nil
+}
+
+func (s SourceSelectors) validate() error {
+	var errs []error
+	for _, kind := range []struct {
+		name     string
+		selector *metav1.LabelSelector
+	}{
+		{"dashboard", s.Dashboard},
+		{"datasource", s.Datasource},
+		{"folder", s.Folder},
+		{"notification", s.NotificationChannel},
+	} {
+		if kind.selector == nil {
+			continue
+		}
+		if _, err := metav1.LabelSelectorAsSelector(kind.selector); err != nil {
+			errs = append(errs, fmt.Errorf("sourceSelector.%s: %w", kind.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// of returns the source filter of the kind, an empty expression passes all objects
+func (f SourceFilters) of(kind string) string {
+	switch kind {
+	case v1alpha1.GrafanaDashboardKind:
+		return f.Dashboard
+	case v1alpha1.GrafanaDataSourceKind:
+		return f.Datasource
+	case v1alpha1.GrafanaFolderKind:
+		return f.Folder
+	case v1alpha1.GrafanaNotificationChannelKind:
+		return f.NotificationChannel
+	}
+	return ""
+}
+
+func (f SourceFilters) validate() error {
+	var errs []error
+	for _, kind := range []struct {
+		name       string
+		expression string
+	}{
+		{"dashboard", f.Dashboard},
+		{"datasource", f.Datasource},
+		{"folder", f.Folder},
+		{"notification", f.NotificationChannel},
+	} {
+		if kind.expression == "" {
+			continue
+		}
+		if _, err := compileSourceFilter(kind.expression); err != nil {
+			errs = append(errs, fmt.Errorf("sourceFilter.%s: %w", kind.name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+var (
+	sourceFilterEnv = sync.OnceValues(func() (*cel.Env, error) {
+		return cel.NewEnv(cel.Variable(sourceFilterVariable, cel.DynType))
+	})
+	// sourceFilterPrograms caches compiled programs by expression, programs are safe for concurrent use
+	sourceFilterPrograms sync.Map
+)
+
+// compileSourceFilter compiles the CEL expression of a source filter, it has to evaluate to a bool
+func compileSourceFilter(expression string) (cel.Program, error) {
+	if program, ok := sourceFilterPrograms.Load(expression); ok {
+		return program.(cel.Program), nil
+	}
+	env, err := sourceFilterEnv()
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expression must evaluate to bool, got %s", ast.OutputType())
+	}
+	program, err := env.Program(ast, cel.CostLimit(sourceFilterCostLimit))
+	if err != nil {
+		return nil, err
+	}
+	sourceFilterPrograms.Store(expression, program)
+	return program, nil
+}
+
+// passesSourceFilter evaluates the source filter of the kind with the v1alpha1 object.
+// Objects which do not pass it are not converted and objects converted from them earlier are left as they are.
+func (c *ConverterController) passesSourceFilter(kind string, source runtime.Object) (bool, error) {
+	expression := c.config().SourceFilter.of(kind)
+	if expression == "" {
+		return true, nil
+	}
+	program, err := compileSourceFilter(expression)
+	if err != nil {
+		return false, err
+	}
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(source)
+	if err != nil {
+		return false, err
+	}
+	result, _, err := program.Eval(map[string]any{sourceFilterVariable: object})
+	if err != nil {
+		return false, err
+	}
+	passes, ok := result.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression must evaluate to bool, got %s", result.Type().TypeName())
+	}
+	if !passes {
+		filteredSourcesTotal.WithLabelValues(kind, filterSourceFilter).Inc()
+	}
+	return passes, nil
+}
+
+// deselectedBySourceSelector reports whether the v1alpha1 object missing in informer caches still exists,
+// so it was not deleted but stopped matching the source selector of the kind. Informers of kinds
+// with a selector do not cache other objects, so such objects can be told from deleted ones only by the API server.
+func (c *ConverterController) deselectedBySourceSelector(ctx context.Context, kind, namespace, name string) (bool, error) {
+	if c.config().SourceSelector.of(kind) == nil {
+		return false, nil
+	}
+	client := c.v1alpha1clientset.IntegreatlyV1alpha1()
+	var err error
+	switch kind {
+	case v1alpha1.GrafanaDashboardKind:
+		_, err = client.GrafanaDashboards(namespace).Get(ctx, name, metav1.GetOptions{})
+	case v1alpha1.GrafanaDataSourceKind:
+		_, err = client.GrafanaDataSources(namespace).Get(ctx, name, metav1.GetOptions{})
+	case v1alpha1.GrafanaFolderKind:
+		_, err = client.GrafanaFolders(namespace).Get(ctx, name, metav1.GetOptions{})
+	case v1alpha1.GrafanaNotificationChannelKind:
+		_, err = client.GrafanaNotificationChannels(namespace).Get(ctx, name, metav1.GetOptions{})
+	default:
+		return false, fmt.Errorf("unknown kind %q", kind)
+	}
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("cannot get %s: %w", kind, err)
+	}
+	filteredSourcesTotal.WithLabelValues(kind, filterSourceSelector).Inc()
+	return true, nil
+}
+
+// newFilteredInformer is the constructor of a generated v1alpha1 informer with list options
+type newFilteredInformer func(client v1alpha1clientset.Interface, namespace string, resyncPeriod time.Duration,
+	indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer
+
+// selectSources registers the informer of the kind in the factory which lists and watches only objects
+// matching the source selector of the kind, it has to be called before the informer is requested from the factory
+func selectSources(factory v1alpha1informers.SharedInformerFactory, kind convertedKind, namespace string, selector *metav1.LabelSelector) error {
+	if selector == nil {
+		return nil
+	}
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return fmt.Errorf("invalid source selector of %s: %w", kind.kind, err)
+	}
+	factory.InformerFor(kind.object, func(client v1alpha1clientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+		return kind.newInformer(client, namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+			func(options *metav1.ListOptions) {
+				options.LabelSelector = labelSelector.String()
+			})
+	})
+	return nil
+}
@@ -0,0 +1,146 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	v1alpha1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func TestParseConfigValidatesSourceFilters(t *testing.T) {
+	_, err := ParseConfig([]byte(`
+sourceSelector:
+  dashboard:
+    matchExpressions:
+    - {key: team, operator: Exists, values: [a]}
+sourceFilter:
+  folder: 'object.metadata.name'
+  notification: 'object.spec.'
+`))
+	assert.ErrorContains(t, err, "sourceSelector.dashboard:")
+	assert.ErrorContains(t, err, "sourceFilter.notification:")
+	assert.NotContains(t, err.Error(), "sourceFilter.folder:", "fields of objects are dynamic, so their type is checked on evaluation")
+
+	_, err = ParseConfig([]byte(`
+sourceFilter:
+  dashboard: '1 + 1'
+`))
+	assert.ErrorContains(t, err, "sourceFilter.dashboard: expression must evaluate to bool")
+
+	conf, err := ParseConfig([]byte(`
+sourceSelector:
+  datasource:
+    matchLabels:
+      team: a
+sourceFilter:
+  datasource: 'object.spec.datasources.exists(d, d.type == "prometheus")'
+`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "a"}, conf.SourceSelector.of(v1alpha1.GrafanaDataSourceKind).MatchLabels)
+	assert.Nil(t, conf.SourceSelector.of(v1alpha1.GrafanaDashboardKind))
+}
+
+func TestSyncGrafanaFolderHonoursSourceFilter(t *testing.T) {
+	existing := &v1beta1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sample-folder",
+			Namespace: "product-a",
+			Labels:    map[string]string{converterManagedLabel: converterManagedValue},
+		},
+		Spec: v1beta1.GrafanaFolderSpec{Title: "Previous"},
+	}
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a", Labels: map[string]string{"team": "b"}},
+		Spec:       v1alpha1.GrafanaFolderSpec{FolderName: "Current"},
+	}
+	client := newFakeV1beta1Clientset(existing)
+	informerFactory := v1alpha1informers.NewSharedInformerFactory(v1alpha1fake.NewSimpleClientset(), 0)
+	store := informerFactory.Integreatly().V1alpha1().GrafanaFolders().Informer().GetStore()
+	require.NoError(t, store.Add(source))
+	controller := &ConverterController{
+		log:                     logr.Discard(),
+		v1alpha1clientset:       v1alpha1fake.NewSimpleClientset(source),
+		v1beta1clientset:        client,
+		v1alpha1InformerFactory: []v1alpha1informers.SharedInformerFactory{informerFactory},
+	}
+	controller.setConfig(ConverterConfig{
+		DeletionPolicy: DeletionPolicies{Folder: DeletionPolicyDelete},
+		SourceFilter:   SourceFilters{Folder: `has(object.metadata.labels) && object.metadata.labels["team"] == "a"`},
+	})
+	title := func() string {
+		folder, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders("product-a").Get(context.Background(), "sample-folder", metav1.GetOptions{})
+		require.NoError(t, err)
+		return folder.Spec.Title
+	}
+
+	require.NoError(t, controller.syncGrafanaFolder(context.Background(), "product-a/sample-folder"))
+	assert.Equal(t, "Previous", title(), "objects converted from filtered sources are left as they are")
+
+	selected := source.DeepCopy()
+	selected.Labels["team"] = "a"
+	require.NoError(t, store.Update(selected))
+	require.NoError(t, controller.syncGrafanaFolder(context.Background(), "product-a/sample-folder"))
+	assert.Equal(t, "Current", title())
+
+	controller.setConfig(ConverterConfig{SourceFilter: SourceFilters{Folder: `object.spec.missing == "x"`}})
+	err := controller.syncGrafanaFolder(context.Background(), "product-a/sample-folder")
+	assert.ErrorContains(t, err, "cannot evaluate source filter of GrafanaFolder")
+	assert.True(t, isPermanent(err))
+}
+
+func TestSourceSelectorNarrowsInformers(t *testing.T) {
+	selected := &v1alpha1.GrafanaFolder{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Namespace: "product-a", Labels: map[string]string{"team": "a"}}}
+	deselected := &v1alpha1.GrafanaFolder{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Namespace: "product-a", Labels: map[string]string{"team": "b"}}}
+	converted := &v1beta1.GrafanaFolder{ObjectMeta: metav1.ObjectMeta{
+		Name:        "team-b",
+		Namespace:   "product-a",
+		Labels:      map[string]string{converterManagedLabel: converterManagedValue},
+		Annotations: map[string]string{sourceNameAnnotationKey: "team-b"},
+	}}
+	client := newFakeV1beta1Clientset(converted)
+	controller := &ConverterController{
+		log:               logr.Discard(),
+		v1alpha1clientset: v1alpha1fake.NewSimpleClientset(selected, deselected),
+		v1beta1clientset:  client,
+	}
+	conf := ConverterConfig{
+		EnabledGrafanaConverter: EnabledGrafanaConverter{Folder: true},
+		DeletionPolicy:          DeletionPolicies{Folder: DeletionPolicyDelete},
+		SourceSelector:          SourceSelectors{Folder: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}},
+	}
+	controller.setConfig(conf)
+	queues := map[string]*kindQueue{v1alpha1.GrafanaFolderKind: newKindQueue(v1alpha1.GrafanaFolderKind, 1, conf.Strategy, controller.syncGrafanaFolder, logr.Discard())}
+	informers, err := controller.newNamespaceInformers("product-a", conf, queues)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	informers.start(ctx)
+	controller.mu.Lock()
+	controller.namespaceInformers = map[string]*namespaceInformers{"product-a": informers}
+	controller.refreshInformers()
+	controller.mu.Unlock()
+
+	cached, err := informers.sources.Integreatly().V1alpha1().GrafanaFolders().Lister().List(labels.Everything())
+	require.NoError(t, err)
+	require.Len(t, cached, 1, "the API server returns only sources matching the selector")
+	assert.Equal(t, "team-a", cached[0].Name)
+
+	require.NoError(t, controller.syncGrafanaFolder(context.Background(), "product-a/team-b"))
+	require.NoError(t, controller.sweepGrafanaFolders(context.Background(), "product-a"))
+	_, err = client.GrafanaIntegreatlyV1beta1().GrafanaFolders("product-a").Get(context.Background(), "team-b", metav1.GetOptions{})
+	assert.NoError(t, err, "objects converted from deselected sources are not deleted")
+
+	require.NoError(t, controller.v1alpha1clientset.IntegreatlyV1alpha1().GrafanaFolders("product-a").Delete(context.Background(), "team-b", metav1.DeleteOptions{}))
+	require.NoError(t, controller.sweepGrafanaFolders(context.Background(), "product-a"))
+	_, err = client.GrafanaIntegreatlyV1beta1().GrafanaFolders("product-a").Get(context.Background(), "team-b", metav1.GetOptions{})
+	assert.True(t, apierrs.IsNotFound(err), "objects converted from deleted sources follow the deletion policy")
+}
@@ -2,132 +2,333 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"maps"
 	"strconv"
 	"strings"
+	"time"
 
+	v1beta1ac "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/applyconfiguration/operator/v1beta1"
 	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
 	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
 	"github.com/go-logr/logr"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
-	"k8s.io/apimachinery/pkg/api/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/utils/ptr"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
 )
 
-// createGrafanaFolder converts GrafanaFolder v1alpha1 to v1beta1
-func (c *ConverterController) createGrafanaFolder(folder interface{}) {
-	alphaFolder, ok := folder.(*v1alpha1.GrafanaFolder)
-	if !ok {
-		c.log.Error(fmt.Errorf("type assertion failed"), "cannot cast to v1alpha1 GrafanaFolder")
-		return
+// getGrafanaFolder returns GrafanaFolder v1alpha1 from informer caches
+func (c *ConverterController) getGrafanaFolder(namespace, name string) (*v1alpha1.GrafanaFolder, error) {
+	for _, informerFactory := range c.sourceInformers() {
+		folder, err := informerFactory.Integreatly().V1alpha1().GrafanaFolders().Lister().GrafanaFolders(namespace).Get(name)
+		if err == nil || !apierrors.IsNotFound(err) {
+			return folder, err
+		}
 	}
-	l := c.log.WithValues("kind", v1alpha1.GrafanaFolderKind, "name", alphaFolder.Name, "ns", alphaFolder.Namespace)
+	return nil, apierrors.NewNotFound(v1alpha1.GroupVersion.WithResource("grafanafolders").GroupResource(), name)
+}
 
-	cr := c.convertGrafanaFolder(alphaFolder)
+// cachedGrafanaFolder returns GrafanaFolder v1beta1 from informer caches of converted objects,
+// nil if converted objects of its namespace are not watched or it is not cached
+func (c *ConverterController) cachedGrafanaFolder(namespace, name string) *v1beta1.GrafanaFolder {
+	for _, informerFactory := range c.convertedInformers() {
+		if folder, err := informerFactory.Observability().V1beta1().GrafanaFolders().Lister().GrafanaFolders(namespace).Get(name); err == nil {
+			return folder
+		}
+	}
+	return nil
+}
 
-	l.Info("start creating GrafanaFolder")
-	betaFolder, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaFolders(cr.Namespace).Create(context.Background(), cr, metav1.CreateOptions{})
+// syncGrafanaFolder converts GrafanaFolder v1alpha1 with the key to v1beta1
+// or propagates its deletion if it no longer exists
+func (c *ConverterController) syncGrafanaFolder(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
-		if errors.IsAlreadyExists(err) {
-			c.updateGrafanaFolder(nil, cr)
-			return
+		c.log.Error(err, "cannot parse GrafanaFolder key", "key", key)
+		return nil
+	}
+	l := c.log.WithValues("kind", v1alpha1.GrafanaFolderKind, "name", name, "ns", namespace)
+
+	start := time.Now()
+	alphaFolder, err := c.getGrafanaFolder(namespace, name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			if c.queue(v1alpha1.GrafanaFolderKind).isDriftOnly(key) {
+				// deletion of the source is handled when its own event is processed
+				return nil
+			}
+			if deselected, err := c.deselectedBySourceSelector(ctx, v1alpha1.GrafanaFolderKind, namespace, name); err != nil || deselected {
+				// the source stopped matching the source selector, objects converted from it are left as they are
+				return err
+			}
+			// the last known state is needed to find the object converted into another namespace or with another name
+			lastState, _ := c.queue(v1alpha1.GrafanaFolderKind).lastState(key)
+			deleted, ok := lastState.(*v1alpha1.GrafanaFolder)
+			if !ok {
+				deleted = &v1alpha1.GrafanaFolder{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+			}
+			err = c.deleteGrafanaFolder(ctx, l, deleted, c.place(v1alpha1.GrafanaFolderKind, deleted))
+			observeConversion(v1alpha1.GrafanaFolderKind, operationDelete, start, err)
+			return err
 		}
-		l.Error(err, "cannot create GrafanaFolder v1beta1 from v1alpha1")
-		return
+		return err
+	}
+	passes, err := c.passesSourceFilter(v1alpha1.GrafanaFolderKind, alphaFolder)
+	if err != nil {
+		return permanent(fmt.Errorf("cannot evaluate source filter of GrafanaFolder: %w", err))
 	}
-	l.Info(fmt.Sprintf("GrafanaFolder %v/%v uid:%v has been created",
-		betaFolder.GetNamespace(),
-		betaFolder.GetName(),
-		betaFolder.GetUID()))
+	if !passes {
+		l.Info("GrafanaFolder does not pass the source filter, it is not converted")
+		return nil
+	}
+	p := c.place(v1alpha1.GrafanaFolderKind, alphaFolder)
+	control, err := controlOf(alphaFolder)
+	switch {
+	case err != nil:
+		err = permanent(fmt.Errorf("invalid control annotations of GrafanaFolder: %w", err))
+	case control.paused:
+		l.Info("GrafanaFolder is paused by annotation, it is not synced")
+		return nil
+	case control.skip:
+		l.Info("GrafanaFolder is skipped by annotation, it is not converted")
+		err = c.deleteGrafanaFolder(ctx, l, alphaFolder, p)
+		observeConversion(v1alpha1.GrafanaFolderKind, operationDelete, start, err)
+		return err
+	default:
+		err = c.reconcileGrafanaFolder(ctx, l, alphaFolder, p, c.reportsDrift(v1alpha1.GrafanaFolderKind, key))
+	}
+	c.recordConversionFailed(alphaFolder, "GrafanaFolder", p.namespace, []string{p.targetName(alphaFolder, name)}, err)
+	err = c.updateGrafanaFolderStatus(ctx, alphaFolder, p, err)
+	if err == nil {
+		err = c.recordGrafanaFolderReferences(ctx, l, alphaFolder, p)
+	}
+	observeConversion(v1alpha1.GrafanaFolderKind, operationConvert, start, err)
+	return err
 }
 
-// updateGrafanaFolder converts GrafanaFolder v1alpha1 to v1beta1
-func (c *ConverterController) updateGrafanaFolder(old, new interface{}) {
-	var folder *v1alpha1.GrafanaFolder
-	var v1beta1Folder *v1beta1.GrafanaFolder
-	var ok bool
-	var l logr.Logger
-	folder, ok = new.(*v1alpha1.GrafanaFolder)
-	if ok && old != nil {
-		l = c.log.WithValues("kind", v1alpha1.GrafanaFolderKind, "name", folder.Name, "ns", folder.Namespace)
-		var alphaFolderOld *v1alpha1.GrafanaFolder
-		alphaFolderOld, ok = old.(*v1alpha1.GrafanaFolder)
-		if !ok {
-			c.log.Error(fmt.Errorf("type assertion failed"), "cannot cast to v1alpha1 GrafanaFolder")
-			return
+// reconcileGrafanaFolder creates or updates GrafanaFolder v1beta1 converted from GrafanaFolder v1alpha1,
+// with reportOnly set it only reports the drift of the converted object
+func (c *ConverterController) reconcileGrafanaFolder(ctx context.Context, l logr.Logger, alphaFolder *v1alpha1.GrafanaFolder, p placement, reportOnly bool) error {
+	l.Info(fmt.Sprintf("start converting GrafanaFolder %s to %s", v1alpha1.GroupVersion.String(), v1beta1.GroupVersion.String()))
+	if p.err != nil {
+		return p.err
+	}
+	meta := c.grafanaFolderObjectMeta(alphaFolder, p)
+	if cached := c.cachedGrafanaFolder(meta.Namespace, meta.Name); cached != nil && c.cachedUpToDate(v1alpha1.GrafanaFolderKind, cached, &meta, p, reportOnly) {
+		l.Info(fmt.Sprintf("GrafanaFolder %v/%v is up to date with its source, it is not applied again", cached.Namespace, cached.Name))
+		return nil
+	}
+	v1beta1Folder := c.convertGrafanaFolder(alphaFolder, p)
+
+	var previous *v1beta1.GrafanaFolder
+	existingFolder, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaFolders(v1beta1Folder.Namespace).Get(ctx, v1beta1Folder.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("cannot get existing GrafanaFolder: %w", err)
 		}
-		if apiequality.Semantic.DeepEqual(alphaFolderOld.Spec, folder.Spec) {
-			l.Info("no diffs in GrafanaFolders")
-			return
+		if reportOnly {
+			l.Info(fmt.Sprintf("GrafanaFolder %v/%v has been deleted, the drift is reported and not restored", v1beta1Folder.Namespace, v1beta1Folder.Name))
+			return nil
 		}
-		l.Info(fmt.Sprintf("start converting GrafanaFolder %s to %s", v1alpha1.GroupVersion.String(), v1beta1.GroupVersion.String()))
-		v1beta1Folder = c.convertGrafanaFolder(folder)
 	} else {
-		v1beta1Folder, ok = new.(*v1beta1.GrafanaFolder)
-		if !ok {
-			l.Error(fmt.Errorf("type assertion failed"), "cannot cast to v1beta1 GrafanaFolder")
-			return
+		previous = existingFolder
+		if !c.canUpdate(existingFolder) {
+			c.recordNotManaged(alphaFolder, existingFolder, "GrafanaFolder", existingFolder.Namespace, existingFolder.Name)
+			return permanent(fmt.Errorf("cannot update existing GrafanaFolder: %w", errNotManaged))
+		}
+		if !p.conf.Strategy.updatesExisting() {
+			l.Info(fmt.Sprintf("GrafanaFolder %v/%v already exists and is not updated with %q strategy", existingFolder.Namespace, existingFolder.Name, SyncStrategyCreateOnly))
+			return nil
+		}
+		if reportOnly {
+			if !apiequality.Semantic.DeepEqual(existingFolder.Spec, v1beta1Folder.Spec) || c.metadataDrifted(existingFolder, v1beta1Folder) {
+				l.Info(fmt.Sprintf("GrafanaFolder %v/%v has drifted from the converted state, the drift is reported and not restored", existingFolder.Namespace, existingFolder.Name))
+			}
+			return nil
+		}
+		if c.isUpToDate(v1alpha1.GrafanaFolderKind, existingFolder, v1beta1Folder) {
+			l.Info(fmt.Sprintf("GrafanaFolder %v/%v is up to date with its source, it is not applied again", existingFolder.Namespace, existingFolder.Name))
+			return nil
+		}
+		adopted, err := backupPreviousSpec(&v1beta1Folder.ObjectMeta, existingFolder, existingFolder.Spec)
+		if err != nil {
+			return permanent(fmt.Errorf("cannot adopt existing GrafanaFolder: %w", err))
+		}
+		if adopted {
+			l.Info(fmt.Sprintf("GrafanaFolder %v/%v is adopted by the converter, its previous spec is kept in %s annotation", existingFolder.Namespace, existingFolder.Name, previousSpecAnnotationKey))
+		}
+		ownershipPatch, err := fieldOwnershipPatch(existingFolder)
+		if err != nil {
+			return permanent(fmt.Errorf("cannot migrate field ownership of existing GrafanaFolder: %w", err))
+		}
+		if ownershipPatch != nil {
+			if _, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaFolders(existingFolder.Namespace).Patch(ctx, existingFolder.Name, types.JSONPatchType, ownershipPatch, metav1.PatchOptions{}); err != nil {
+				return fmt.Errorf("cannot migrate field ownership of existing GrafanaFolder: %w", err)
+			}
+			l.Info(fmt.Sprintf("GrafanaFolder %v/%v fields updated before server-side apply are now owned by %s", existingFolder.Namespace, existingFolder.Name, fieldManager))
+		}
+		if instanceSelectorChanged(existingFolder.Spec.InstanceSelector, v1beta1Folder.Spec.InstanceSelector) {
+			client := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaFolders(existingFolder.Namespace)
+			err = recreateConverted(ctx, l, "GrafanaFolder", existingFolder,
+				func(ctx context.Context, options metav1.DeleteOptions) error {
+					return client.Delete(ctx, existingFolder.Name, options)
+				},
+				func(ctx context.Context) error {
+					_, err := client.Get(ctx, existingFolder.Name, metav1.GetOptions{})
+					return err
+				})
+			if err != nil {
+				return err
+			}
 		}
-		l = c.log.WithValues("kind", v1alpha1.GrafanaFolderKind, "name", v1beta1Folder.Name, "ns", v1beta1Folder.Namespace)
 	}
 
-	ctx := context.Background()
-	existingFolder, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaFolders(v1beta1Folder.Namespace).Get(ctx, v1beta1Folder.Name, metav1.GetOptions{})
+	applyConfiguration, err := grafanaFolderApplyConfiguration(v1beta1Folder)
 	if err != nil {
-		if errors.IsNotFound(err) {
-			var createdFolder *v1beta1.GrafanaFolder
-			if createdFolder, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaFolders(v1beta1Folder.Namespace).Create(ctx, v1beta1Folder, metav1.CreateOptions{}); err == nil {
-				l.Info(fmt.Sprintf("GrafanaDashboard %v/%v uid:%v has been created",
-					createdFolder.GetNamespace(),
-					createdFolder.GetName(),
-					createdFolder.GetUID()))
-				return
-			}
+		return permanent(fmt.Errorf("cannot build apply configuration of GrafanaFolder: %w", err))
+	}
+	appliedFolder, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaFolders(v1beta1Folder.Namespace).Apply(ctx, applyConfiguration, applyOptions)
+	if err != nil {
+		return fmt.Errorf("cannot apply GrafanaFolder: %w", err)
+	}
+	l.Info(fmt.Sprintf("GrafanaFolder %v/%v uid:%v has been applied",
+		appliedFolder.GetNamespace(),
+		appliedFolder.GetName(),
+		appliedFolder.GetUID()))
+	// applies which change nothing keep the resource version and are not recorded as conversions
+	if previous == nil || appliedFolder.ResourceVersion != previous.ResourceVersion {
+		c.recordConverted(alphaFolder, appliedFolder, "GrafanaFolder", appliedFolder.Namespace, appliedFolder.Name, nil)
+	}
+	return nil
+}
+
+// updateGrafanaFolderStatus writes the result of the conversion to the status of GrafanaFolder v1alpha1,
+// the conversion error is returned as is to be retried by the queue
+func (c *ConverterController) updateGrafanaFolderStatus(ctx context.Context, src *v1alpha1.GrafanaFolder, p placement, convertErr error) error {
+	status := conversionStatus(src.Status.Conversion, src.Generation, []string{p.targetName(src, src.Name)}, convertErr)
+	if apiequality.Semantic.DeepEqual(src.Status.Conversion, status) {
+		return convertErr
+	}
+	updated := src.DeepCopy()
+	updated.Status.Conversion = status
+	if _, err := c.v1alpha1clientset.IntegreatlyV1alpha1().GrafanaFolders(src.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return errors.Join(convertErr, fmt.Errorf("cannot update status of GrafanaFolder: %w", err))
+	}
+	return convertErr
+}
+
+// recordGrafanaFolderReferences annotates GrafanaFolder v1alpha1 with references to the GrafanaFolder v1beta1 objects converted from it
+func (c *ConverterController) recordGrafanaFolderReferences(ctx context.Context, l logr.Logger, src *v1alpha1.GrafanaFolder, p placement) error {
+	names := []string{p.targetName(src, src.Name)}
+	if err := c.deleteRetargetedObjects(ctx, l, src, "GrafanaFolder", p.conf.DeletionPolicy.Folder, p.namespace, names, c.deleteConvertedGrafanaFolder); err != nil {
+		return err
+	}
+	patch, err := convertedObjectsPatch(src, "GrafanaFolder", p.namespace, names)
+	if err != nil || patch == nil {
+		return err
+	}
+	if _, err = c.v1alpha1clientset.IntegreatlyV1alpha1().GrafanaFolders(src.Namespace).Patch(ctx, src.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("cannot record converted objects on GrafanaFolder: %w", err)
+	}
+	return nil
+}
+
+// deleteGrafanaFolder propagates deletion of GrafanaFolder v1alpha1 to v1beta1
+func (c *ConverterController) deleteGrafanaFolder(ctx context.Context, l logr.Logger, src *v1alpha1.GrafanaFolder, p placement) error {
+	namespace, name := p.namespace, p.targetName(src, src.Name)
+	if policy := c.convertedDeletionPolicy(p.conf.DeletionPolicy.Folder, src, namespace); policy != DeletionPolicyDelete {
+		l.Info(fmt.Sprintf("GrafanaFolder has been deleted or skipped, converted GrafanaFolder is left to %q deletion policy", policy))
+		if policy == DeletionPolicyOrphan {
+			c.recordOrphaned(v1alpha1.GrafanaFolderKind, src.Namespace+"/"+src.Name, "GrafanaFolder", namespace, []string{name})
 		}
-		l.Error(err, "cannot get existing GrafanaFolder")
-		return
+		return nil
+	}
+	return c.deleteConvertedGrafanaFolder(ctx, l, namespace, name)
+}
+
+// deleteConvertedGrafanaFolder deletes GrafanaFolder v1beta1 if it is managed by the converter
+func (c *ConverterController) deleteConvertedGrafanaFolder(ctx context.Context, l logr.Logger, namespace, name string) error {
+	existingFolder, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaFolders(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("cannot get existing GrafanaFolder: %w", err)
 	}
 	if !isConverterManaged(existingFolder) {
-		l.Error(fmt.Errorf("resource is not managed by the converter"), "cannot update existing GrafanaFolder")
-		return
+		l.Error(errNotManaged, "cannot delete existing GrafanaFolder")
+		return nil
 	}
 
-	if apiequality.Semantic.DeepEqual(existingFolder.Spec, v1beta1Folder.Spec) {
-		l.Info("no updates in GrafanaFolders")
-		return
+	err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaFolders(namespace).Delete(ctx, name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &existingFolder.UID},
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("cannot delete GrafanaFolder: %w", err)
 	}
+	l.Info(fmt.Sprintf("GrafanaFolder %v/%v has been deleted", namespace, name))
+	return nil
+}
 
-	existingFolder.Spec = v1beta1Folder.Spec
-	maps.Copy(existingFolder.Annotations, v1beta1Folder.GetAnnotations())
-	maps.Copy(existingFolder.Labels, v1beta1Folder.GetLabels())
-	existingFolder.OwnerReferences = v1beta1Folder.GetOwnerReferences()
-
-	var updatedFolder *v1beta1.GrafanaFolder
-	updatedFolder, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaFolders(existingFolder.Namespace).Update(ctx, existingFolder, metav1.UpdateOptions{})
+// sweepGrafanaFolders deletes converted GrafanaFolders v1beta1 whose source no longer exists
+func (c *ConverterController) sweepGrafanaFolders(ctx context.Context, namespace string) error {
+	folders, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaFolders(namespace).List(ctx, managedByOperatorSelector)
 	if err != nil {
-		l.Error(err, "cannot update GrafanaFolder")
-		return
+		return err
+	}
+	var errs error
+	for _, folder := range folders.Items {
+		sourceName, ok := sourceNameOf(&folder)
+		if !ok {
+			sourceName = folder.Name
+		}
+		exists, err := c.convertedSourceExists(ctx, v1alpha1.GrafanaFolderKind, &folder, sourceName)
+		if err != nil || exists {
+			continue
+		}
+		l := c.log.WithValues("kind", v1alpha1.GrafanaFolderKind, "name", sourceName, "ns", folder.Namespace)
+		l.Info(fmt.Sprintf("source of GrafanaFolder %v/%v no longer exists", folder.Namespace, folder.Name))
+		errs = errors.Join(errs, c.deleteConvertedGrafanaFolder(ctx, l, folder.Namespace, folder.Name))
+	}
+	return errs
+}
+
+// grafanaFolderApplyConfiguration declares fields of the converted GrafanaFolder owned by the converter
+func grafanaFolderApplyConfiguration(dst *v1beta1.GrafanaFolder) (*v1beta1ac.GrafanaFolderApplyConfiguration, error) {
+	applyConfiguration := v1beta1ac.GrafanaFolder(dst.Name, dst.Namespace)
+	applyConfiguration.ObjectMetaApplyConfiguration = objectMetaApplyConfiguration(dst.ObjectMeta)
+	applyConfiguration.Spec = &v1beta1ac.GrafanaFolderSpecApplyConfiguration{}
+	return applyConfiguration, specApplyConfiguration(dst.Spec, applyConfiguration.Spec)
+}
+
+// grafanaFolderObjectMeta returns the metadata of GrafanaFolder v1beta1 converted from GrafanaFolder v1alpha1
+// with its provenance, it does not need the converted spec
+func (c *ConverterController) grafanaFolderObjectMeta(src *v1alpha1.GrafanaFolder, p placement) metav1.ObjectMeta {
+	meta := c.convertedObjectMeta(src, p.namespace, p.targetName(src, src.Name), p.conf.Propagation)
+	p.conf.Defaults.Folder.applyMetadata(&meta)
+	if p.conf.DeletionPolicy.Folder == DeletionPolicyOwnerReference {
+		c.setSourceOwnerReference(&meta, src, v1alpha1.GrafanaFolderKind)
 	}
-	l.Info(fmt.Sprintf("GrafanaFolder %v/%v uid:%v has been updated",
-		updatedFolder.GetNamespace(),
-		updatedFolder.GetName(),
-		updatedFolder.GetUID()))
+	stampProvenance(&meta, src, v1alpha1.GrafanaFolderKind, src.Hash(), p.conf)
+	return meta
 }
 
 // convertGrafanaFolder creates GrafanaFolder v1beta1 from GrafanaFolder v1alpha1
-func (c *ConverterController) convertGrafanaFolder(src *v1alpha1.GrafanaFolder) (dst *v1beta1.GrafanaFolder) {
+func (c *ConverterController) convertGrafanaFolder(src *v1alpha1.GrafanaFolder, p placement) (dst *v1beta1.GrafanaFolder) {
 	c.log.Info(fmt.Sprintf("%s/%s conversion from %s to %s requested", src.Namespace, src.Name, v1alpha1.GroupVersion.String(), v1beta1.GroupVersion.String()))
+	conf := p.conf
+	defaults := conf.Defaults.Folder
 
 	dst = &v1beta1.GrafanaFolder{
-		ObjectMeta: convertedObjectMeta(src, src.Name),
+		ObjectMeta: c.grafanaFolderObjectMeta(src, p),
 		Spec: v1beta1.GrafanaFolderSpec{
 			Title:                     src.Spec.FolderName,
 			Permissions:               buildFolderPermission(src.GetPermissions()),
-			InstanceSelector:          c.ConverterConf.InstanceSelector,
-			AllowCrossNamespaceImport: ptr.To(true),
-			ResyncPeriod:              v1beta1.DefaultResyncPeriod,
+			InstanceSelector:          conf.InstanceSelector,
+			AllowCrossNamespaceImport: allowCrossNamespaceImport(conf, defaults),
+			ResyncPeriod:              defaults.resyncPeriodString(),
 		},
 	}
 
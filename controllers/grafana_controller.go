@@ -1,29 +1,66 @@
 package controllers
 
 import (
-	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"regexp"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	converterclientset "github.com/Netcracker/qubership-grafana-operator-converter/api/client/converter/clientset/versioned"
+	converterlisters "github.com/Netcracker/qubership-grafana-operator-converter/api/client/converter/listers/converter/v1alpha1"
 	v1alpha1clientset "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned"
 	v1alpha1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions"
+	alphainformers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions/operator/v1alpha1"
 	v1beta1clientset "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/clientset/versioned"
+	v1beta1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/informers/externalversions"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
 	"github.com/go-logr/logr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	kjson "sigs.k8s.io/json"
+	"sigs.k8s.io/yaml"
 )
 
 // ConverterConfig defines converter configuration for Grafana v1alpha1 to v1beta1 api versions
 type ConverterConfig struct {
-	Enable                  bool                  `json:"enable,omitempty" yaml:"enable,omitempty"`
-	Strategy                string                `json:"strategy,omitempty" yaml:"strategy,omitempty"`
-	InstanceSelector        *metav1.LabelSelector `json:"instanceSelector,omitempty" yaml:"instanceSelector,omitempty"`
+	Enable           bool                  `json:"enable,omitempty" yaml:"enable,omitempty"`
+	Strategy         SyncStrategy          `json:"strategy,omitempty" yaml:"strategy,omitempty"`
+	InstanceSelector *metav1.LabelSelector `json:"instanceSelector,omitempty" yaml:"instanceSelector,omitempty"`
+	DeletionPolicy   DeletionPolicies      `json:"deletionPolicy,omitempty" yaml:"deletionPolicy,omitempty"`
+	DriftPolicy      DriftPolicy           `json:"driftPolicy,omitempty" yaml:"driftPolicy,omitempty"`
+	AdoptionPolicy   AdoptionPolicy        `json:"adoptionPolicy,omitempty" yaml:"adoptionPolicy,omitempty"`
+	Workers          Workers               `json:"workers,omitempty" yaml:"workers,omitempty"`
+	// InstanceSelectorMode defines whether instance selectors of dashboards are derived from legacy Grafanas
+	InstanceSelectorMode InstanceSelectorMode `json:"instanceSelectorMode,omitempty" yaml:"instanceSelectorMode,omitempty"`
+	// InstanceSelectorRules route v1alpha1 objects to Grafana instances, the first matching rule wins over InstanceSelector
+	InstanceSelectorRules []InstanceSelectorRule `json:"instanceSelectorRules,omitempty" yaml:"instanceSelectorRules,omitempty"`
+	// IsolationMode defines whether Grafana instances may import converted objects of other namespaces
+	IsolationMode IsolationMode `json:"isolationMode,omitempty" yaml:"isolationMode,omitempty"`
+	// Tenants pair namespaces of v1alpha1 objects with namespaces of Grafana instances with tenant isolation
+	Tenants []Tenant `json:"tenants,omitempty" yaml:"tenants,omitempty"`
+	// TargetNamespace maps v1alpha1 objects to namespaces of converted objects
+	TargetNamespace TargetNamespace `json:"targetNamespace,omitempty" yaml:"targetNamespace,omitempty"`
+	// Defaults define per kind fields of converted objects which v1alpha1 objects do not set
+	Defaults Defaults `json:"defaults,omitempty" yaml:"defaults,omitempty"`
+	// Propagation defines which labels and annotations of v1alpha1 objects are copied to converted objects
+	Propagation Propagation `json:"propagation,omitempty" yaml:"propagation,omitempty"`
+	// SourceSelector and SourceFilter narrow v1alpha1 objects converted per kind
+	SourceSelector SourceSelectors `json:"sourceSelector,omitempty" yaml:"sourceSelector,omitempty"`
+	SourceFilter   SourceFilters   `json:"sourceFilter,omitempty" yaml:"sourceFilter,omitempty"`
+	// FolderTitle is the Grafana folder of dashboards which do not set customFolderName
+	FolderTitle string `json:"folderTitle,omitempty" yaml:"folderTitle,omitempty"`
+	// NamespaceOptIn converts only namespaces which enable the converter with an override
+	NamespaceOptIn          bool `json:"namespaceOptIn,omitempty" yaml:"namespaceOptIn,omitempty"`
 	EnabledGrafanaConverter `json:",inline" yaml:",inline"`
 }
 type EnabledGrafanaConverter struct {
@@ -33,121 +70,503 @@ type EnabledGrafanaConverter struct {
 	NotificationChannel bool `json:"notification,omitempty" yaml:"notification,omitempty"`
 }
 
+// DeletionPolicies defines per kind what happens with converted objects when a v1alpha1 source is deleted
+type DeletionPolicies struct {
+	Dashboard           DeletionPolicy `json:"dashboard,omitempty" yaml:"dashboard,omitempty"`
+	Datasource          DeletionPolicy `json:"datasource,omitempty" yaml:"datasource,omitempty"`
+	Folder              DeletionPolicy `json:"folder,omitempty" yaml:"folder,omitempty"`
+	NotificationChannel DeletionPolicy `json:"notification,omitempty" yaml:"notification,omitempty"`
+}
+
+// Workers defines per kind how many v1alpha1 objects are converted concurrently, 0 means one worker
+type Workers struct {
+	Dashboard           int `json:"dashboard,omitempty" yaml:"dashboard,omitempty"`
+	Datasource          int `json:"datasource,omitempty" yaml:"datasource,omitempty"`
+	Folder              int `json:"folder,omitempty" yaml:"folder,omitempty"`
+	NotificationChannel int `json:"notification,omitempty" yaml:"notification,omitempty"`
+}
+
 // ConverterController - watches for grafana integreatly.org/v1alpha1 objects
 // and create\update grafana.integreatly.org/v1beta1 objects
 type ConverterController struct {
-	ctx                     context.Context
-	log                     logr.Logger
-	ConverterConf           ConverterConfig
-	v1beta1clientset        v1beta1clientset.Interface
+	ctx               context.Context
+	log               logr.Logger
+	configPath        string
+	conf              atomic.Pointer[ConverterConfig]
+	resyncPeriod      time.Duration
+	v1alpha1clientset v1alpha1clientset.Interface
+	v1beta1clientset  v1beta1clientset.Interface
+	recorder          events.EventRecorder
+	// separateTargetCluster is set when v1beta1clientset writes to another cluster than the one of v1alpha1 objects
+	separateTargetCluster bool
+
+	// configurationClientset reads the ConverterConfiguration which takes precedence over the configuration file,
+	// configurationChanged signals the configuration watcher that it was changed
+	configurationClientset converterclientset.Interface
+	configurationLister    converterlisters.ConverterConfigurationLister
+	configurationChanged   chan struct{}
+	// resourceConfigured is set while the configuration is read from the ConverterConfiguration
+	resourceConfigured bool
+	// statusMu serializes status updates of the ConverterConfiguration, lastLoadErr is the error of its last load
+	statusMu    sync.Mutex
+	lastLoadErr error
+
+	// kubeclientset watches namespaces selected by namespaceFilter and converter overrides of namespaces
+	kubeclientset   kubernetes.Interface
+	namespaceFilter *namespaceFilter
+	namespaceLister corelisters.NamespaceLister
+
+	// watchMu serializes replacing informers on reloads and on changes of selected namespaces
+	watchMu sync.Mutex
+	// mu guards informers, queues and configErr, informers and queues are replaced when the configuration is reloaded
+	mu                      sync.RWMutex
 	v1alpha1InformerFactory []v1alpha1informers.SharedInformerFactory
+	v1beta1InformerFactory  []v1beta1informers.SharedInformerFactory
+	handlerRegistrations    []cache.ResourceEventHandlerRegistration
+	queues                  map[string]*kindQueue
+	stopInformers           context.CancelFunc
+	// namespaceInformers are informers by watched namespace, metav1.NamespaceAll holds informers of all namespaces,
+	// informersCtx and informersConf are used to start informers of namespaces selected later
+	namespaceInformers map[string]*namespaceInformers
+	selectedNamespaces map[string]bool
+	// startingNamespaces cancel informers of selected namespaces which are being synced in the background
+	startingNamespaces map[string]context.CancelFunc
+	// targetNamespaces are informers by target namespace of converted objects outside of watched namespaces
+	targetNamespaces map[string]*targetInformers
+	// grafanas are informers of Grafanas, nil if the configuration does not need them
+	grafanas      *grafanaInformers
+	informersCtx  context.Context
+	informersConf ConverterConfig
+	// configErr is the error of the last load of the configuration, the converter keeps its previous one then
+	configErr error
 }
 
 // NewGrafanaConverterController builder for grafana converter service
-func NewGrafanaConverterController(ctx context.Context, converterConfigPath string, v1alpha1clientset v1alpha1clientset.Interface, v1beta1clientset v1beta1clientset.Interface, resyncPeriod time.Duration, log logr.Logger) (*ConverterController, error) {
+func NewGrafanaConverterController(ctx context.Context, converterConfigPath string, v1alpha1clientset v1alpha1clientset.Interface, v1beta1clientset v1beta1clientset.Interface, configurationClientset converterclientset.Interface, kubeclientset kubernetes.Interface, recorder events.EventRecorder, resyncPeriod time.Duration, log logr.Logger) (*ConverterController, error) {
 	c := &ConverterController{
-		ctx:              ctx,
-		log:              log,
-		ConverterConf:    ConverterConfig{},
-		v1beta1clientset: v1beta1clientset,
+		ctx:               ctx,
+		log:               log,
+		configPath:        converterConfigPath,
+		resyncPeriod:      resyncPeriod,
+		v1alpha1clientset: v1alpha1clientset,
+		v1beta1clientset:  v1beta1clientset,
+		queues:            map[string]*kindQueue{},
+		recorder:          recorder,
+
+		configurationClientset: configurationClientset,
+		configurationChanged:   make(chan struct{}, 1),
+		kubeclientset:          kubeclientset,
+		namespaceInformers:     map[string]*namespaceInformers{},
+		selectedNamespaces:     map[string]bool{},
+		startingNamespaces:     map[string]context.CancelFunc{},
 	}
 
+	namespaceFilter, err := getNamespaceFilter()
+	if err != nil {
+		return c, err
+	}
+	c.namespaceFilter = namespaceFilter
+
+	if _, err := os.Stat(converterConfigPath); os.IsNotExist(err) {
+		log.Info("grafana converter configuration file does not exist, the converter is disabled until it is created", "path", converterConfigPath)
+	}
 	converterConfig, err := ReadConfig(converterConfigPath)
 	if err != nil {
-		log.Error(err, "can not read grafana converter configuration file, disabling grafana converter...")
+		log.Error(err, "can not read grafana converter configuration file")
 		return c, err
 	}
 
 	log.Info(fmt.Sprintf("converter config: %+v\n", converterConfig))
-	c.ConverterConf = *converterConfig
-	if c.ConverterConf.Enable && c.ConverterConf.EnabledGrafanaConverter != (EnabledGrafanaConverter{}) {
-		namespaces := mustGetWatchNamespaces()
-		if len(namespaces) == 0 {
-			c.v1alpha1InformerFactory = append(c.v1alpha1InformerFactory, v1alpha1informers.NewSharedInformerFactory(v1alpha1clientset, resyncPeriod))
+	c.conf.Store(converterConfig)
+	return c, nil
+}
+
+// UseSeparateTargetCluster tells the converter that its v1beta1 clientset writes to another cluster than the one
+// v1alpha1 objects are read from. Converted objects get no owner references then, as they can not point to objects
+// of another cluster, and the converter deletes them itself with the ownerReference deletion policy.
+func (c *ConverterController) UseSeparateTargetCluster() {
+	c.separateTargetCluster = true
+}
+
+// config returns the current converter configuration, it is replaced as a whole when the configuration is reloaded
+func (c *ConverterController) config() ConverterConfig {
+	if conf := c.conf.Load(); conf != nil {
+		return *conf
+	}
+	return ConverterConfig{}
+}
+
+// setConfig replaces the converter configuration
+func (c *ConverterController) setConfig(conf ConverterConfig) {
+	c.conf.Store(&conf)
+}
+
+// setConfigErr records the error of the last load of the configuration, nil when it was applied
+func (c *ConverterController) setConfigErr(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.configErr = err
+}
+
+// queue returns the work queue of the kind, or nil if the kind is not converted
+func (c *ConverterController) queue(kind string) *kindQueue {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.queues[kind]
+}
+
+// queuedKinds returns kinds which have a work queue
+func (c *ConverterController) queuedKinds() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	kinds := make([]string, 0, len(c.queues))
+	for kind := range c.queues {
+		kinds = append(kinds, kind)
+	}
+	return kinds
+}
+
+// sourceInformers returns informer factories of v1alpha1 objects
+func (c *ConverterController) sourceInformers() []v1alpha1informers.SharedInformerFactory {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.v1alpha1InformerFactory
+}
+
+// convertedInformers returns informer factories of converted v1beta1 objects
+func (c *ConverterController) convertedInformers() []v1beta1informers.SharedInformerFactory {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.v1beta1InformerFactory
+}
+
+// addQueue creates the work queue of the kind converted by the sync function
+func (c *ConverterController) addQueue(kind string, workers int, sync syncFunc) *kindQueue {
+	queue := newKindQueue(kind, workers, c.config().Strategy, sync, c.log)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.queues[kind] = queue
+	return queue
+}
+
+// convertedKind describes how v1alpha1 objects of one kind are watched and converted
+type convertedKind struct {
+	kind     string
+	enabled  bool
+	workers  int
+	sync     syncFunc
+	informer func(v1alpha1informers.SharedInformerFactory) cache.SharedIndexInformer
+	// object and newInformer register the informer narrowed by the source selector of the kind
+	object      runtime.Object
+	newInformer newFilteredInformer
+}
+
+// convertedKinds returns all kinds the converter supports with their settings in the configuration
+func (c *ConverterController) convertedKinds(conf ConverterConfig) []convertedKind {
+	return []convertedKind{
+		{v1alpha1.GrafanaDashboardKind, conf.Dashboard, conf.Workers.Dashboard, c.syncGrafanaDashboard,
+			func(f v1alpha1informers.SharedInformerFactory) cache.SharedIndexInformer {
+				return f.Integreatly().V1alpha1().GrafanaDashboards().Informer()
+			}, &v1alpha1.GrafanaDashboard{}, alphainformers.NewFilteredGrafanaDashboardInformer},
+		{v1alpha1.GrafanaDataSourceKind, conf.Datasource, conf.Workers.Datasource, c.syncGrafanaDatasource,
+			func(f v1alpha1informers.SharedInformerFactory) cache.SharedIndexInformer {
+				return f.Integreatly().V1alpha1().GrafanaDataSources().Informer()
+			}, &v1alpha1.GrafanaDataSource{}, alphainformers.NewFilteredGrafanaDataSourceInformer},
+		{v1alpha1.GrafanaFolderKind, conf.Folder, conf.Workers.Folder, c.syncGrafanaFolder,
+			func(f v1alpha1informers.SharedInformerFactory) cache.SharedIndexInformer {
+				return f.Integreatly().V1alpha1().GrafanaFolders().Informer()
+			}, &v1alpha1.GrafanaFolder{}, alphainformers.NewFilteredGrafanaFolderInformer},
+		{v1alpha1.GrafanaNotificationChannelKind, conf.NotificationChannel, conf.Workers.NotificationChannel, c.syncGrafanaNotificationChannel,
+			func(f v1alpha1informers.SharedInformerFactory) cache.SharedIndexInformer {
+				return f.Integreatly().V1alpha1().GrafanaNotificationChannels().Informer()
+			}, &v1alpha1.GrafanaNotificationChannel{}, alphainformers.NewFilteredGrafanaNotificationChannelInformer},
+	}
+}
+
+// Start implements interface.
+// It blocks until the context is cancelled by the manager.
+func (c *ConverterController) Start(ctx context.Context) error {
+	c.log.Info("starting grafana converter")
+
+	if err := c.watchConfiguration(ctx); err != nil {
+		return err
+	}
+	var loadErr error
+	configuration := c.configuration()
+	if configuration != nil {
+		c.resourceConfigured = true
+		var conf *ConverterConfig
+		if conf, loadErr = c.loadConfig(configuration); loadErr != nil {
+			c.log.Error(loadErr, "invalid ConverterConfiguration, keeping the configuration file", "name", configuration.Name)
 		} else {
-			for _, ns := range namespaces {
-				c.v1alpha1InformerFactory = append(c.v1alpha1InformerFactory, v1alpha1informers.NewSharedInformerFactoryWithOptions(v1alpha1clientset, resyncPeriod, v1alpha1informers.WithNamespace(ns)))
-			}
+			c.log.Info(fmt.Sprintf("converter config from ConverterConfiguration %s: %+v", configuration.Name, *conf))
+			c.conf.Store(conf)
 		}
+	}
+	c.setConfigErr(loadErr)
 
-		if c.ConverterConf.Dashboard {
-			for _, informer := range c.v1alpha1InformerFactory {
-				if _, err = informer.Integreatly().V1alpha1().GrafanaDashboards().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-					AddFunc:    c.createGrafanaDashboard,
-					UpdateFunc: c.updateGrafanaDashboard,
-				}); err != nil {
-					return nil, fmt.Errorf("cannot add grafana dashboards handler: %w", err)
-				}
-			}
+	if err := c.watchNamespaces(ctx); err != nil {
+		return err
+	}
+	if err := c.watch(ctx, c.config()); err != nil {
+		return err
+	}
+	c.updateConfigurationStatus(ctx, configuration, c.config(), loadErr)
+
+	if err := metrics.Registry.Register(sourceCollector{c}); err != nil {
+		c.log.Error(err, "cannot register metrics of sources")
+	}
+
+	configWatched := make(chan struct{})
+	go func() {
+		defer close(configWatched)
+		c.watchConfig(ctx)
+	}()
+
+	<-ctx.Done()
+	c.log.Info("stopping grafana converter")
+	<-configWatched
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, queue := range c.queues {
+		queue.shutDown()
+	}
+	return nil
+}
+
+// watch replaces informers and work queues with the ones of the configuration. Queues of kinds whose workers
+// and strategy are unchanged keep running, new informers enqueue all v1alpha1 objects of converted kinds again.
+func (c *ConverterController) watch(ctx context.Context, conf ConverterConfig) error {
+	c.watchMu.Lock()
+	defer c.watchMu.Unlock()
+	informersCtx, stopInformers := context.WithCancel(ctx)
+	var (
+		namespaceInformers   = map[string]*namespaceInformers{}
+		grafanas             *grafanaInformers
+		handlerRegistrations []cache.ResourceEventHandlerRegistration
+		queues               = map[string]*kindQueue{}
+		started              []*kindQueue
+	)
+	// new queues are not started yet, so they are dropped on errors
+	fail := func(err error) error {
+		stopInformers()
+		for _, queue := range started {
+			queue.shutDown()
 		}
+		return err
+	}
 
-		if c.ConverterConf.Datasource {
-			for _, informer := range c.v1alpha1InformerFactory {
-				if _, err = informer.Integreatly().V1alpha1().GrafanaDataSources().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-					AddFunc:    c.createGrafanaDatasource,
-					UpdateFunc: c.updateGrafanaDatasource,
-				}); err != nil {
-					return nil, fmt.Errorf("cannot add grafana datasource handler: %w", err)
-				}
+	enabled := conf.Enable && conf.EnabledGrafanaConverter != (EnabledGrafanaConverter{})
+	if enabled {
+		for _, kind := range c.convertedKinds(conf) {
+			if !kind.enabled {
+				continue
 			}
+			queue := c.queue(kind.kind)
+			if queue == nil || queue.workers != max(kind.workers, 1) || queue.strategy != conf.Strategy {
+				queue = newKindQueue(kind.kind, kind.workers, conf.Strategy, c.inWatchedNamespaces(kind.kind, kind.sync), c.log)
+				started = append(started, queue)
+			}
+			queues[kind.kind] = queue
 		}
 
-		if c.ConverterConf.Folder {
-			for _, informer := range c.v1alpha1InformerFactory {
-				if _, err = informer.Integreatly().V1alpha1().GrafanaFolders().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-					AddFunc:    c.createGrafanaFolder,
-					UpdateFunc: c.updateGrafanaFolder,
-				}); err != nil {
-					return nil, fmt.Errorf("cannot add grafana folder handler: %w", err)
-				}
+		var err error
+		if grafanas, err = c.newGrafanaInformers(conf); err != nil {
+			return fail(err)
+		}
+		for _, ns := range c.watchedNamespaces() {
+			informers, err := c.newNamespaceInformers(ns, conf, queues)
+			if err != nil {
+				return fail(err)
 			}
+			namespaceInformers[ns] = informers
+			handlerRegistrations = append(handlerRegistrations, informers.registrations...)
 		}
+	}
 
-		if c.ConverterConf.NotificationChannel {
-			for _, informer := range c.v1alpha1InformerFactory {
-				if _, err = informer.Integreatly().V1alpha1().GrafanaNotificationChannels().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-					AddFunc:    c.createGrafanaNotificationChannel,
-					UpdateFunc: c.updateGrafanaNotificationChannel,
-				}); err != nil {
-					return nil, fmt.Errorf("cannot add grafana notification channel handler: %w", err)
-				}
-			}
+	// Grafanas are synced first, so sources are routed and placed with them
+	if grafanas != nil {
+		grafanas.start(informersCtx)
+	}
+	for _, informers := range namespaceInformers {
+		informers.start(informersCtx)
+	}
+
+	// replaced queues finish keys being converted before caches are replaced,
+	// otherwise sources of kinds missing in new caches are taken for deleted ones
+	c.mu.RLock()
+	previousQueues := c.queues
+	c.mu.RUnlock()
+	for kind, queue := range previousQueues {
+		if queues[kind] != queue {
+			queue.shutDown()
 		}
 	}
 
-	return c, nil
-}
+	c.mu.Lock()
+	// informers of all selected namespaces are replaced, including the ones being started in the background
+	for _, cancelStart := range c.startingNamespaces {
+		cancelStart()
+	}
+	c.startingNamespaces = map[string]context.CancelFunc{}
+	c.namespaceInformers = namespaceInformers
+	// target namespaces are watched again once sources are placed with the new configuration
+	c.targetNamespaces = map[string]*targetInformers{}
+	c.grafanas = grafanas
+	c.queues = queues
+	c.refreshInformers()
+	c.informersCtx, c.informersConf = informersCtx, conf
+	stopPreviousInformers := c.stopInformers
+	c.stopInformers = stopInformers
+	c.mu.Unlock()
+	if stopPreviousInformers != nil {
+		stopPreviousInformers()
+	}
 
-// Start implements interface.
-// nolint:unparam
-func (c *ConverterController) Start(ctx context.Context) error {
-	c.log.Info("starting grafana converter")
+	if !enabled {
+		c.log.Info("grafana converter is disabled")
+		return nil
+	}
+
+	c.sweepOrphans(ctx)
+	// target namespaces are swept when they are watched
+	c.watchTargetNamespaces(conf)
 
-	for _, informerFactory := range c.v1alpha1InformerFactory {
-		informerFactory.Start(ctx.Done())
-		informerFactory.WaitForCacheSync(ctx.Done())
+	// workers start after caches are synced, otherwise sources missing in caches are taken for deleted ones
+	for _, queue := range started {
+		queue.run(ctx)
 	}
 
-	c.log.Info("grafana converter started")
+	switch conf.Strategy.orDefault() {
+	case SyncStrategyOneShot:
+		// wait until handlers enqueue the initial inventory and stop watching for changes,
+		// failed conversions are still retried by workers
+		for _, registration := range handlerRegistrations {
+			cache.WaitForCacheSync(ctx.Done(), registration.HasSynced)
+		}
+		stopInformers()
+		c.log.Info("grafana converter enqueued current inventory and stopped watching with oneShot strategy")
+	case SyncStrategyMirror:
+		go c.sweepOrphansPeriodically(informersCtx, c.informersResyncPeriod(conf))
+		c.log.Info("grafana converter started")
+	default:
+		c.log.Info("grafana converter started")
+	}
 	return nil
 }
 
-func ReadConfig(path string) (*ConverterConfig, error) {
-	converterConfig := &ConverterConfig{}
+// informersResyncPeriod returns the resync period of v1alpha1 informers with the configuration,
+// the mirror strategy needs periodic resyncs even if no period is set
+func (c *ConverterController) informersResyncPeriod(conf ConverterConfig) time.Duration {
+	if conf.Strategy.enforcesState() && c.resyncPeriod == 0 {
+		return defaultMirrorResyncPeriod
+	}
+	return c.resyncPeriod
+}
+
+// sweepOrphansPeriodically repeats the orphans sweep every period until the context is done
+func (c *ConverterController) sweepOrphansPeriodically(ctx context.Context, period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.sweepOrphans(ctx)
+		}
+	}
+}
 
-	f, err := os.Open(path)
+// ReadConfig reads the converter configuration file, a missing file means that the converter is disabled.
+// Unknown and duplicated fields are rejected, all problems found in the file are returned joined in one error.
+func ReadConfig(path string) (*ConverterConfig, error) {
+	data, err := os.ReadFile(path)
 	if err != nil {
 		if os.IsNotExist(err) {
 			return &ConverterConfig{}, nil
 		}
 		return &ConverterConfig{}, err
 	}
-	if err = yaml.NewYAMLOrJSONDecoder(bufio.NewReader(f), 100).Decode(&converterConfig); err != nil {
+	return ParseConfig(data)
+}
+
+// ParseConfig decodes and validates the converter configuration in YAML or JSON
+func ParseConfig(data []byte) (*ConverterConfig, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return &ConverterConfig{}, fmt.Errorf("cannot parse configuration: %w", err)
+	}
+	converterConfig := &ConverterConfig{}
+	strictErrs, err := kjson.UnmarshalStrict(jsonData, converterConfig, kjson.DisallowDuplicateFields, kjson.DisallowUnknownFields)
+	if err != nil {
+		return &ConverterConfig{}, fmt.Errorf("cannot decode configuration: %w", err)
+	}
+	if err = errors.Join(append(strictErrs, converterConfig.validate())...); err != nil {
 		return &ConverterConfig{}, err
 	}
 	return converterConfig, nil
 }
 
+// validate returns all problems of the configuration joined in one error
+func (c *ConverterConfig) validate() error {
+	var errs []error
+	if err := c.Strategy.validate(); err != nil {
+		errs = append(errs, fmt.Errorf("strategy: %w", err))
+	}
+	if c.InstanceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(c.InstanceSelector); err != nil {
+			errs = append(errs, fmt.Errorf("instanceSelector: %w", err))
+		}
+	}
+	if err := c.InstanceSelectorMode.validate(); err != nil {
+		errs = append(errs, fmt.Errorf("instanceSelectorMode: %w", err))
+	}
+	if err := validateInstanceSelectorRules(c.InstanceSelectorRules); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.IsolationMode.validate(); err != nil {
+		errs = append(errs, fmt.Errorf("isolationMode: %w", err))
+	}
+	if err := validateTenants(c.Tenants); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.TargetNamespace.validate(c.IsolationMode); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Defaults.validate(c.IsolationMode); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.Propagation.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.DriftPolicy.validate(); err != nil {
+		errs = append(errs, fmt.Errorf("driftPolicy: %w", err))
+	}
+	if err := c.AdoptionPolicy.validate(); err != nil {
+		errs = append(errs, fmt.Errorf("adoptionPolicy: %w", err))
+	}
+	return errors.Join(append(errs, c.DeletionPolicy.validate(), c.Workers.validate(), c.SourceSelector.validate(), c.SourceFilter.validate())...)
+}
+
+func (w Workers) validate() error {
+	var errs []error
+	for _, kind := range []struct {
+		name    string
+		workers int
+	}{
+		{"dashboard", w.Dashboard},
+		{"datasource", w.Datasource},
+		{"folder", w.Folder},
+		{"notification", w.NotificationChannel},
+	} {
+		if kind.workers < 0 {
+			errs = append(errs, fmt.Errorf("workers.%s: must not be negative, got %d", kind.name, kind.workers))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 var (
 	// WatchNamespaceEnvVar is the constant for env variable WATCH_NAMESPACE
 	// which specifies the Namespace to watch.
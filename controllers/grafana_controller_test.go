@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+)
+
+func TestParseConfigRejectsUnknownFields(t *testing.T) {
+	_, err := ParseConfig([]byte("enable: true\ndashbaord: true\nworkers:\n  folders: 2\n"))
+
+	assert.ErrorContains(t, err, `unknown field "dashbaord"`)
+	assert.ErrorContains(t, err, `unknown field "workers.folders"`)
+}
+
+func TestParseConfigReportsAllProblems(t *testing.T) {
+	_, err := ParseConfig([]byte(`
+enable: true
+strategy: mirrored
+instanceSelector:
+  matchExpressions:
+    - key: app
+      operator: Equals
+      values: [grafana]
+deletionPolicy:
+  dashboard: remove
+  folder: keep
+workers:
+  dashboard: -1
+`))
+
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "strategy: unknown strategy")
+	assert.ErrorContains(t, err, "instanceSelector:")
+	assert.ErrorContains(t, err, "deletionPolicy.dashboard:")
+	assert.ErrorContains(t, err, "deletionPolicy.folder:")
+	assert.ErrorContains(t, err, "workers.dashboard: must not be negative")
+}
+
+func TestParseConfigAcceptsChartValues(t *testing.T) {
+	data, err := os.ReadFile("../charts/qubership-grafana-operator-converter/values.yaml")
+	require.NoError(t, err)
+	var values struct {
+		Grafana struct {
+			Converter map[string]interface{} `json:"converter"`
+		} `json:"grafana"`
+	}
+	require.NoError(t, yaml.Unmarshal(data, &values))
+	converter, err := yaml.Marshal(values.Grafana.Converter)
+	require.NoError(t, err)
+
+	conf, err := ParseConfig(converter)
+
+	require.NoError(t, err)
+	assert.True(t, conf.Enable)
+}
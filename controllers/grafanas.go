@@ -0,0 +1,196 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	v1alpha1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions"
+	v1beta1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/informers/externalversions"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// grafanaInformers are informers of legacy v1alpha1 Grafanas, which route dashboards and set their defaults,
+// and of v1beta1 Grafanas, which are needed to route objects to migrated Grafanas and to place them with tenant isolation.
+// Grafanas are watched in all namespaces the converter may read, because objects of other namespaces are routed to them,
+// v1beta1 Grafanas also in namespaces of tenant Grafanas outside of watched namespaces.
+type grafanaInformers struct {
+	legacy   []v1alpha1informers.SharedInformerFactory
+	migrated []v1beta1informers.SharedInformerFactory
+}
+
+// newGrafanaInformers creates informers of Grafanas for the configuration, it returns nil if they are not needed
+func (c *ConverterController) newGrafanaInformers(conf ConverterConfig) (*grafanaInformers, error) {
+	// dashboards take the content cache duration of legacy Grafanas importing them before the configured default
+	watchLegacy := conf.InstanceSelectorMode == InstanceSelectorModeLegacyGrafana ||
+		conf.Dashboard && conf.Defaults.Dashboard.ContentCacheDuration != nil
+	watchMigrated := conf.InstanceSelectorMode == InstanceSelectorModeLegacyGrafana || conf.IsolationMode == IsolationModeTenant
+	if !watchLegacy && !watchMigrated {
+		return nil, nil
+	}
+	namespaces := mustGetWatchNamespaces()
+	if len(namespaces) == 0 {
+		namespaces = []string{metav1.NamespaceAll}
+	}
+	if watchLegacy {
+		var err error
+		if watchLegacy, err = c.legacyGrafanasListable(namespaces); err != nil {
+			return nil, err
+		}
+	}
+	// changed Grafanas may route or place objects in other instances
+	changed := cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(_ interface{}, isInInitialList bool) {
+			if !isInInitialList {
+				c.grafanasChanged()
+			}
+		},
+		UpdateFunc: func(old, new interface{}) {
+			oldGrafana, oldOk := old.(metav1.Object)
+			newGrafana, newOk := new.(metav1.Object)
+			if !oldOk || !newOk || oldGrafana.GetGeneration() != newGrafana.GetGeneration() || !maps.Equal(oldGrafana.GetLabels(), newGrafana.GetLabels()) {
+				c.grafanasChanged()
+			}
+		},
+		DeleteFunc: func(interface{}) { c.grafanasChanged() },
+	}
+	informers := &grafanaInformers{}
+	for _, ns := range grafanaNamespaces(namespaces, conf) {
+		if watchLegacy && slices.Contains(namespaces, ns) {
+			legacy := v1alpha1informers.NewSharedInformerFactoryWithOptions(c.v1alpha1clientset, 0, v1alpha1informers.WithNamespace(ns))
+			if _, err := legacy.Integreatly().V1alpha1().Grafanas().Informer().AddEventHandler(changed); err != nil {
+				return nil, fmt.Errorf("cannot watch legacy Grafanas: %w", err)
+			}
+			informers.legacy = append(informers.legacy, legacy)
+		}
+		if watchMigrated {
+			migrated := v1beta1informers.NewSharedInformerFactoryWithOptions(c.v1beta1clientset, 0, v1beta1informers.WithNamespace(ns))
+			if _, err := migrated.Observability().V1beta1().Grafanas().Informer().AddEventHandler(changed); err != nil {
+				return nil, fmt.Errorf("cannot watch Grafanas: %w", err)
+			}
+			informers.migrated = append(informers.migrated, migrated)
+		}
+	}
+	return informers, nil
+}
+
+// grafanaNamespaces returns namespaces whose Grafanas are watched: the watched namespaces and, unless all namespaces
+// are watched, the namespaces of tenant Grafanas, which objects of watched namespaces are placed in
+func grafanaNamespaces(namespaces []string, conf ConverterConfig) []string {
+	if slices.Contains(namespaces, metav1.NamespaceAll) || conf.IsolationMode != IsolationModeTenant {
+		return namespaces
+	}
+	watched := slices.Clone(namespaces)
+	for _, tenant := range conf.Tenants {
+		if !slices.Contains(watched, tenant.GrafanaNamespace) {
+			watched = append(watched, tenant.GrafanaNamespace)
+		}
+	}
+	return watched
+}
+
+// legacyGrafanasListable reports whether legacy Grafanas can be listed in the namespaces, it returns false
+// if their CRD is not installed or the converter is not allowed to list them
+func (c *ConverterController) legacyGrafanasListable(namespaces []string) (bool, error) {
+	for _, ns := range namespaces {
+		if _, err := c.v1alpha1clientset.IntegreatlyV1alpha1().Grafanas(ns).List(c.ctx, metav1.ListOptions{Limit: 1}); err != nil {
+			if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+				c.log.Info("legacy Grafanas can not be listed, dashboards are not routed by them and do not take their content cache duration", "ns", ns, "reason", err.Error())
+				return false, nil
+			}
+			return false, fmt.Errorf("cannot list legacy Grafanas: %w", err)
+		}
+	}
+	return true, nil
+}
+
+// start starts informers and waits until their caches are synced, they are stopped when the context is done
+func (i *grafanaInformers) start(ctx context.Context) {
+	for _, factory := range i.legacy {
+		factory.Start(ctx.Done())
+	}
+	for _, factory := range i.migrated {
+		factory.Start(ctx.Done())
+	}
+	for _, factory := range i.legacy {
+		factory.WaitForCacheSync(ctx.Done())
+	}
+	for _, factory := range i.migrated {
+		factory.WaitForCacheSync(ctx.Done())
+	}
+}
+
+// grafanas returns legacy v1alpha1 Grafanas and v1beta1 Grafanas from informer caches
+func (i *grafanaInformers) grafanas() ([]*v1alpha1.Grafana, []*v1beta1.Grafana, error) {
+	var (
+		legacy   []*v1alpha1.Grafana
+		migrated []*v1beta1.Grafana
+	)
+	for _, factory := range i.legacy {
+		grafanas, err := factory.Integreatly().V1alpha1().Grafanas().Lister().List(labels.Everything())
+		if err != nil {
+			return nil, nil, err
+		}
+		legacy = append(legacy, grafanas...)
+	}
+	for _, factory := range i.migrated {
+		grafanas, err := factory.Observability().V1beta1().Grafanas().Lister().List(labels.Everything())
+		if err != nil {
+			return nil, nil, err
+		}
+		migrated = append(migrated, grafanas...)
+	}
+	// listers return objects in random order
+	slices.SortFunc(legacy, func(a, b *v1alpha1.Grafana) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+	slices.SortFunc(migrated, func(a, b *v1beta1.Grafana) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+	return legacy, migrated, nil
+}
+
+// watchedGrafanas returns informers of Grafanas, or an error if Grafanas are not watched with the current configuration
+func (c *ConverterController) watchedGrafanas() (*grafanaInformers, error) {
+	c.mu.RLock()
+	informers := c.grafanas
+	c.mu.RUnlock()
+	if informers == nil {
+		return nil, errors.New("watching of Grafanas is not enabled")
+	}
+	return informers, nil
+}
+
+// grafanasChanged converts v1alpha1 objects of converted kinds again after Grafanas changed
+func (c *ConverterController) grafanasChanged() {
+	c.mu.RLock()
+	queues, informerFactories := c.queues, c.v1alpha1InformerFactory
+	c.mu.RUnlock()
+	for _, kind := range c.convertedKinds(ConverterConfig{}) {
+		queue, ok := queues[kind.kind]
+		if !ok {
+			continue
+		}
+		for _, informerFactory := range informerFactories {
+			for _, obj := range kind.informer(informerFactory).GetStore().List() {
+				queue.enqueue(obj)
+			}
+		}
+	}
+}
+
+func grafanaNames(grafanas []*v1beta1.Grafana) string {
+	names := make([]string, 0, len(grafanas))
+	for _, grafana := range grafanas {
+		names = append(names, grafana.Namespace+"/"+grafana.Name)
+	}
+	return strings.Join(names, ", ")
+}
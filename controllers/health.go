@@ -0,0 +1,79 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+)
+
+// queueStallTimeout is how long all workers of a kind may process keys without finishing any
+// while other keys are waiting, before the converter is reported as not alive
+const queueStallTimeout = 10 * time.Minute
+
+// ReadyzChecks returns readiness checks of supported kinds and of the configuration by check name,
+// a kind is ready when its informers delivered the initial state of v1alpha1 and converted objects to its queue,
+// kinds which are not converted with the current configuration are always ready, converted ones are not ready
+// until the converter started their queues.
+// The configuration is not ready while its last load or reload failed.
+func (c *ConverterController) ReadyzChecks() map[string]healthz.Checker {
+	checks := map[string]healthz.Checker{
+		"converter-config": func(*http.Request) error {
+			c.mu.RLock()
+			defer c.mu.RUnlock()
+			if c.configErr != nil {
+				return fmt.Errorf("the converter keeps its previous configuration: %w", c.configErr)
+			}
+			return nil
+		},
+	}
+	for _, kind := range c.convertedKinds(ConverterConfig{}) {
+		checks[healthCheckName(kind.kind)] = func(*http.Request) error {
+			converted := c.converts(kind.kind)
+			c.mu.RLock()
+			defer c.mu.RUnlock()
+			queue, ok := c.queues[kind.kind]
+			// queues are installed after informers of the configuration synced
+			if ok && !queue.hasSynced() || !ok && converted {
+				return fmt.Errorf("%s informer caches are not synced", kind.kind)
+			}
+			return nil
+		}
+	}
+	return checks
+}
+
+// converts reports whether the kind is converted with the current configuration
+func (c *ConverterController) converts(kind string) bool {
+	conf := c.config()
+	if !conf.Enable {
+		return false
+	}
+	for _, converted := range c.convertedKinds(conf) {
+		if converted.kind == kind {
+			return converted.enabled
+		}
+	}
+	return false
+}
+
+// HealthzChecks returns liveness checks of supported kinds by check name,
+// a kind is not alive when its queue is stalled
+func (c *ConverterController) HealthzChecks() map[string]healthz.Checker {
+	checks := map[string]healthz.Checker{}
+	for _, kind := range c.convertedKinds(ConverterConfig{}) {
+		checks[healthCheckName(kind.kind)] = func(*http.Request) error {
+			if queue := c.queue(kind.kind); queue != nil && queue.stalled(queueStallTimeout) {
+				return fmt.Errorf("%s workers have not finished any conversion for %s", kind.kind, queueStallTimeout)
+			}
+			return nil
+		}
+	}
+	return checks
+}
+
+func healthCheckName(kind string) string {
+	return "converter-" + strings.ToLower(kind)
+}
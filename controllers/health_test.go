@@ -0,0 +1,81 @@
+package controllers
+
+import (
+	"context"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestReadyzCheckWaitsForInformerSync(t *testing.T) {
+	controller := &ConverterController{log: logr.Discard(), queues: map[string]*kindQueue{}}
+	queue := controller.addQueue(v1alpha1.GrafanaFolderKind, 1, func(context.Context, string) error { return nil })
+	defer queue.shutDown()
+	var sourcesSynced, convertedSynced atomic.Bool
+	queue.synced = append(queue.synced, sourcesSynced.Load, convertedSynced.Load)
+	check := controller.ReadyzChecks()["converter-grafanafolder"]
+	require.NotNil(t, check)
+
+	assert.ErrorContains(t, check(nil), "GrafanaFolder informer caches are not synced")
+
+	sourcesSynced.Store(true)
+	assert.Error(t, check(nil), "the drift informer of converted objects is not synced yet")
+
+	convertedSynced.Store(true)
+	assert.NoError(t, check(nil))
+}
+
+func TestReadyzCheckFailsUntilConverterWatches(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "parameters.yaml")
+	writeConfig(t, path, "enable: true\nfolder: true\n")
+	controller, err := NewGrafanaConverterController(context.Background(), path, v1alpha1fake.NewSimpleClientset(
+		&v1alpha1.GrafanaFolder{ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"}},
+	), newFakeV1beta1Clientset(), nil, nil, nil, 0, logr.Discard())
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	check := controller.ReadyzChecks()["converter-grafanafolder"]
+	require.NotNil(t, check)
+
+	assert.ErrorContains(t, check(nil), "GrafanaFolder informer caches are not synced", "caches are not synced before the converter watches")
+	assert.NoError(t, controller.ReadyzChecks()["converter-grafanadashboard"](nil), "kinds which are not converted are ready")
+
+	require.NoError(t, controller.watch(ctx, controller.config()))
+	assert.Eventually(t, func() bool { return check(nil) == nil }, 5*time.Second, 10*time.Millisecond,
+		"the check passes once handlers delivered the initial state to the queue")
+}
+
+func TestHealthzCheckFailsWhenQueueStalls(t *testing.T) {
+	release := make(chan struct{})
+	controller := &ConverterController{log: logr.Discard(), queues: map[string]*kindQueue{}}
+	queue := controller.addQueue(v1alpha1.GrafanaDashboardKind, 1, func(ctx context.Context, key string) error {
+		<-release
+		return nil
+	})
+	defer queue.shutDown()
+	check := controller.HealthzChecks()["converter-grafanadashboard"]
+	require.NotNil(t, check)
+
+	queue.queue.Add("product-a/first")
+	processed := make(chan bool)
+	go func() { processed <- queue.processNextItem(context.Background()) }()
+	require.Eventually(t, func() bool { return queue.busy.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+	// the only worker is busy, but another key is not waiting yet
+	assert.False(t, queue.stalled(0))
+
+	queue.queue.Add("product-a/second")
+	assert.True(t, queue.stalled(0))
+	assert.NoError(t, check(nil), "a worker may process a key for less than the stall timeout")
+
+	close(release)
+	require.True(t, <-processed)
+	assert.False(t, queue.stalled(0))
+}
@@ -0,0 +1,130 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+)
+
+// IsolationMode defines whether Grafana instances may import converted objects of other namespaces
+type IsolationMode string
+
+const (
+	// IsolationModeNone - converted objects are placed in the namespace of their sources and allow cross-namespace import,
+	// it is the default mode
+	IsolationModeNone IsolationMode = "none"
+	// IsolationModeTenant - converted objects do not allow cross-namespace import, they are placed in the namespace
+	// of Grafana instances they are imported into
+	IsolationModeTenant IsolationMode = "tenant"
+)
+
+func (m IsolationMode) validate() error {
+	switch m {
+	case "", IsolationModeNone, IsolationModeTenant:
+		return nil
+	}
+	return fmt.Errorf("unknown isolation mode %q, must be one of: %q, %q", m, IsolationModeNone, IsolationModeTenant)
+}
+
+// Tenant pairs namespaces of v1alpha1 objects with the namespace of their Grafana instances
+type Tenant struct {
+	// GrafanaNamespace is the namespace of Grafana instances of the tenant
+	GrafanaNamespace string `json:"grafanaNamespace" yaml:"grafanaNamespace"`
+	// Namespaces are namespaces of v1alpha1 objects which may be placed in GrafanaNamespace
+	Namespaces []string `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
+}
+
+// validateTenants returns problems of all tenants joined in one error
+func validateTenants(tenants []Tenant) error {
+	var errs []error
+	for i, tenant := range tenants {
+		if tenant.GrafanaNamespace == "" {
+			errs = append(errs, fmt.Errorf("tenants[%d].grafanaNamespace: must be set", i))
+		} else {
+			for _, msg := range validation.IsDNS1123Label(tenant.GrafanaNamespace) {
+				errs = append(errs, fmt.Errorf("tenants[%d].grafanaNamespace: %q: %s", i, tenant.GrafanaNamespace, msg))
+			}
+		}
+		for _, namespace := range tenant.Namespaces {
+			for _, msg := range validation.IsDNS1123Label(namespace) {
+				errs = append(errs, fmt.Errorf("tenants[%d].namespaces: %q: %s", i, namespace, msg))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// paired reports whether v1alpha1 objects of the namespace may be placed in the namespace of Grafana instances
+func paired(tenants []Tenant, grafanaNamespace, namespace string) bool {
+	if grafanaNamespace == namespace {
+		return true
+	}
+	return slices.ContainsFunc(tenants, func(tenant Tenant) bool {
+		return tenant.GrafanaNamespace == grafanaNamespace && slices.Contains(tenant.Namespaces, namespace)
+	})
+}
+
+// errUnplaceable is returned for v1alpha1 objects whose converted objects can not be placed in their target namespace,
+// e.g. because they would need cross-namespace import with tenant isolation
+var errUnplaceable = errors.New("cannot place converted objects in their target namespace")
+
+// allowCrossNamespaceImport returns whether objects converted with the configuration and the defaults of their kind
+// allow cross-namespace import
+func allowCrossNamespaceImport(conf ConverterConfig, defaults KindDefaults) *bool {
+	if defaults.AllowCrossNamespaceImport != nil {
+		return ptr.To(*defaults.AllowCrossNamespaceImport)
+	}
+	return ptr.To(conf.IsolationMode != IsolationModeTenant)
+}
+
+// placedNamespace returns the namespace of objects converted from the v1alpha1 object of the kind with the configuration
+// of the object, which carries its instance selector. Without tenant isolation it is the namespace the target namespace
+// configuration maps the object to. With tenant isolation it is the namespace of all Grafana instances the instance
+// selector of the object selects, it must be the namespace of the object or be paired with it.
+func (c *ConverterController) placedNamespace(conf ConverterConfig, kind string, source metav1.Object) (string, error) {
+	if conf.IsolationMode != IsolationModeTenant {
+		return mappedNamespace(conf, kind, source)
+	}
+	informers, err := c.watchedGrafanas()
+	if err != nil {
+		return "", err
+	}
+	_, grafanas, err := informers.grafanas()
+	if err != nil {
+		return "", fmt.Errorf("cannot list Grafanas: %w", err)
+	}
+	// a nil instance selector selects no Grafana instances
+	selector, err := metav1.LabelSelectorAsSelector(conf.InstanceSelector)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", errUnplaceable, err)
+	}
+
+	var selected []*v1beta1.Grafana
+	for _, grafana := range grafanas {
+		if selector.Matches(labels.Set(grafana.Labels)) {
+			selected = append(selected, grafana)
+		}
+	}
+	if len(selected) == 0 {
+		return "", fmt.Errorf("%w: instance selector %q selects no Grafana instances", errUnplaceable, selector)
+	}
+	namespace := selected[0].Namespace
+	if slices.ContainsFunc(selected, func(grafana *v1beta1.Grafana) bool { return grafana.Namespace != namespace }) {
+		return "", fmt.Errorf("%w: instance selector %q selects Grafana instances %s of several namespaces", errUnplaceable, selector, grafanaNames(selected))
+	}
+	if annotated := source.GetAnnotations()[targetNamespaceAnnotationKey]; annotated != "" && annotated != namespace {
+		return "", fmt.Errorf("%w: annotation %s sets namespace %s, but Grafana instances %s are in namespace %s",
+			errUnplaceable, targetNamespaceAnnotationKey, annotated, grafanaNames(selected), namespace)
+	}
+	if !paired(conf.Tenants, namespace, source.GetNamespace()) {
+		return "", fmt.Errorf("%w: namespace %s is not paired with namespace %s of Grafana instances %s",
+			errUnplaceable, source.GetNamespace(), namespace, grafanaNames(selected))
+	}
+	return namespace, nil
+}
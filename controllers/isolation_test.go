@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"testing"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	v1beta1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/clientset/versioned/fake"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestTenantsValidation(t *testing.T) {
+	_, err := ParseConfig([]byte(`
+isolationMode: shared
+tenants:
+- namespaces: [Tenant_A]
+`))
+	assert.ErrorContains(t, err, `isolationMode: unknown isolation mode "shared"`)
+	assert.ErrorContains(t, err, "tenants[0].grafanaNamespace: must be set")
+	assert.ErrorContains(t, err, `tenants[0].namespaces: "Tenant_A"`)
+}
+
+func TestTenantIsolationPlacement(t *testing.T) {
+	controller := &ConverterController{
+		log:               logr.Discard(),
+		v1alpha1clientset: v1alpha1fake.NewSimpleClientset(),
+		v1beta1clientset:  v1beta1fake.NewSimpleClientset(),
+	}
+	conf := ConverterConfig{
+		IsolationMode:    IsolationModeTenant,
+		InstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "grafana-tenant-a"}},
+		InstanceSelectorRules: []InstanceSelectorRule{
+			{Namespaces: []string{"tenant-b"}, InstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "grafana-tenant-b"}}},
+			{Namespaces: []string{"shared"}, InstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "shared"}}},
+			{Namespaces: []string{"nowhere"}, InstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "missing"}}},
+		},
+		Tenants: []Tenant{{GrafanaNamespace: "monitoring-a", Namespaces: []string{"tenant-a"}}},
+	}
+	controller.setConfig(conf)
+	informers, err := controller.newGrafanaInformers(conf)
+	require.NoError(t, err)
+	controller.grafanas = informers
+	for _, grafana := range []*v1beta1.Grafana{
+		{ObjectMeta: metav1.ObjectMeta{Name: "grafana", Namespace: "monitoring-a", Labels: map[string]string{"app": "grafana-tenant-a", "tier": "shared"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "grafana", Namespace: "monitoring-b", Labels: map[string]string{"app": "grafana-tenant-b", "tier": "shared"}}},
+	} {
+		require.NoError(t, informers.migrated[0].Observability().V1beta1().Grafanas().Informer().GetStore().Add(grafana))
+	}
+	dashboard := func(namespace string, annotations map[string]string) *v1alpha1.GrafanaDashboard {
+		return &v1alpha1.GrafanaDashboard{ObjectMeta: metav1.ObjectMeta{Name: "sample-dashboard", Namespace: namespace, Annotations: annotations}}
+	}
+
+	source := dashboard("tenant-a", nil)
+	p := controller.place(v1alpha1.GrafanaDashboardKind, source)
+	require.NoError(t, p.err)
+	assert.Equal(t, "monitoring-a", p.namespace, "objects of paired namespaces are placed in the namespace of their Grafana instances")
+
+	converted := controller.convertGrafanaDashboard(source, p)
+	assert.Equal(t, "monitoring-a", converted.Namespace)
+	assert.Equal(t, ptr.To(false), converted.Spec.AllowCrossNamespaceImport)
+
+	p = controller.place(v1alpha1.GrafanaDashboardKind, dashboard("monitoring-a", nil))
+	require.NoError(t, p.err)
+	assert.Equal(t, "monitoring-a", p.namespace, "objects of the Grafana namespace are always placed")
+
+	err = controller.place(v1alpha1.GrafanaDashboardKind, dashboard("tenant-b", nil)).err
+	assert.ErrorIs(t, err, errUnplaceable)
+	assert.ErrorContains(t, err, "namespace tenant-b is not paired with namespace monitoring-b of Grafana instances monitoring-b/grafana")
+
+	err = controller.place(v1alpha1.GrafanaDashboardKind, dashboard("shared", nil)).err
+	assert.ErrorContains(t, err, "selects Grafana instances monitoring-a/grafana, monitoring-b/grafana of several namespaces")
+
+	err = controller.place(v1alpha1.GrafanaDashboardKind, dashboard("nowhere", nil)).err
+	assert.ErrorContains(t, err, `instance selector "app=missing" selects no Grafana instances`)
+
+	err = controller.place(v1alpha1.GrafanaDashboardKind, dashboard("tenant-a", map[string]string{targetNamespaceAnnotationKey: "tenant-a"})).err
+	assert.ErrorContains(t, err, "sets namespace tenant-a, but Grafana instances monitoring-a/grafana are in namespace monitoring-a")
+
+	err = controller.place(v1alpha1.GrafanaDashboardKind, dashboard("tenant-b", nil)).err
+	assert.True(t, isPermanent(err), "unplaceable objects are converted again when Grafanas change")
+}
+
+func TestTenantGrafanaNamespacesAreWatched(t *testing.T) {
+	conf := ConverterConfig{
+		IsolationMode: IsolationModeTenant,
+		Tenants: []Tenant{
+			{GrafanaNamespace: "monitoring-a", Namespaces: []string{"tenant-a"}},
+			{GrafanaNamespace: "tenant-b"},
+		},
+	}
+	assert.Equal(t, []string{"tenant-a", "tenant-b", "monitoring-a"}, grafanaNamespaces([]string{"tenant-a", "tenant-b"}, conf),
+		"Grafanas of tenants are watched even if their namespaces are not")
+	assert.Equal(t, []string{metav1.NamespaceAll}, grafanaNamespaces([]string{metav1.NamespaceAll}, conf))
+	assert.Equal(t, []string{"tenant-a"}, grafanaNamespaces([]string{"tenant-a"}, ConverterConfig{Tenants: conf.Tenants}))
+}
@@ -0,0 +1,136 @@
+package controllers
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// InstanceSelectorMode defines where instance selectors of converted objects come from
+type InstanceSelectorMode string
+
+const (
+	// InstanceSelectorModeStatic - instance selectors come from the configuration, it is the default mode
+	InstanceSelectorModeStatic InstanceSelectorMode = "static"
+	// InstanceSelectorModeLegacyGrafana - dashboards are routed to the v1beta1 Grafanas which legacy v1alpha1 Grafanas
+	// importing their sources were migrated to, the configuration is used for other kinds and for sources
+	// no legacy Grafana imports
+	InstanceSelectorModeLegacyGrafana InstanceSelectorMode = "legacyGrafana"
+)
+
+func (m InstanceSelectorMode) validate() error {
+	switch m {
+	case "", InstanceSelectorModeStatic, InstanceSelectorModeLegacyGrafana:
+		return nil
+	}
+	return fmt.Errorf("unknown instance selector mode %q, must be one of: %q, %q", m, InstanceSelectorModeStatic, InstanceSelectorModeLegacyGrafana)
+}
+
+// importedByLegacyGrafana reports whether the legacy Grafana imported the v1alpha1 dashboard
+// with its dashboardLabelSelector and dashboardNamespaceSelector
+func (c *ConverterController) importedByLegacyGrafana(grafana *v1alpha1.Grafana, dashboard *v1alpha1.GrafanaDashboard) (bool, error) {
+	matches, err := dashboard.MatchesSelectors(grafana.Spec.DashboardLabelSelector)
+	if err != nil || !matches || grafana.Spec.DashboardNamespaceSelector == nil {
+		return matches, err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(grafana.Spec.DashboardNamespaceSelector)
+	if err != nil || selector.Empty() {
+		return err == nil, err
+	}
+	if c.namespaceLister == nil {
+		return false, fmt.Errorf("labels of namespace %s can not be read to match dashboardNamespaceSelector", dashboard.Namespace)
+	}
+	namespace, err := c.namespaceLister.Get(dashboard.Namespace)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(namespace.Labels)), nil
+}
+
+// legacyInstanceSelector returns the instance selector of v1beta1 Grafanas which legacy Grafanas importing the v1alpha1
+// object were migrated to, or nil if it is not a dashboard or no legacy Grafana imports it. A v1beta1 Grafana
+// is the migrated one if it has the name and the namespace of the legacy Grafana. The selector matches labels
+// all of them share and must not select other v1beta1 Grafanas.
+func (c *ConverterController) legacyInstanceSelector(source metav1.Object) (*metav1.LabelSelector, error) {
+	// legacy Grafanas select only dashboards, other kinds are routed by the configuration
+	dashboard, ok := source.(*v1alpha1.GrafanaDashboard)
+	if !ok {
+		return nil, nil
+	}
+	informers, err := c.watchedGrafanas()
+	if err != nil {
+		return nil, err
+	}
+	legacy, migrated, err := informers.grafanas()
+	if err != nil {
+		return nil, fmt.Errorf("cannot list Grafanas: %w", err)
+	}
+
+	var importing []*v1beta1.Grafana
+	for _, grafana := range legacy {
+		imported, err := c.importedByLegacyGrafana(grafana, dashboard)
+		if err != nil {
+			return nil, fmt.Errorf("cannot match legacy Grafana %s/%s: %w", grafana.Namespace, grafana.Name, err)
+		}
+		if !imported {
+			continue
+		}
+		index := slices.IndexFunc(migrated, func(g *v1beta1.Grafana) bool {
+			return g.Namespace == grafana.Namespace && g.Name == grafana.Name
+		})
+		if index < 0 {
+			return nil, fmt.Errorf("legacy Grafana %s/%s which imports the object is not migrated to a %s Grafana yet", grafana.Namespace, grafana.Name, v1beta1.GroupVersion)
+		}
+		importing = append(importing, migrated[index])
+	}
+	if len(importing) == 0 {
+		return nil, nil
+	}
+
+	shared := maps.Clone(importing[0].Labels)
+	for _, grafana := range importing[1:] {
+		maps.DeleteFunc(shared, func(key, value string) bool {
+			return grafana.Labels[key] != value
+		})
+	}
+	if len(shared) == 0 {
+		return nil, fmt.Errorf("migrated Grafanas %s which import the object share no labels to select them", grafanaNames(importing))
+	}
+	selector := labels.SelectorFromSet(shared)
+	for _, grafana := range migrated {
+		if selector.Matches(labels.Set(grafana.Labels)) && !slices.Contains(importing, grafana) {
+			return nil, fmt.Errorf("instance selector %s of Grafanas %s would select Grafana %s/%s which does not import the object",
+				selector, grafanaNames(importing), grafana.Namespace, grafana.Name)
+		}
+	}
+	return &metav1.LabelSelector{MatchLabels: shared}, nil
+}
+
+// legacyContentCacheDuration returns the shortest dashboardContentCacheDuration of legacy Grafanas importing
+// the v1alpha1 dashboard, or nil if none of them sets it. Legacy Grafanas which can not be matched are skipped.
+func (c *ConverterController) legacyContentCacheDuration(dashboard *v1alpha1.GrafanaDashboard) *metav1.Duration {
+	informers, err := c.watchedGrafanas()
+	if err != nil {
+		return nil
+	}
+	legacy, _, err := informers.grafanas()
+	if err != nil {
+		return nil
+	}
+	var shortest *metav1.Duration
+	for _, grafana := range legacy {
+		duration := grafana.Spec.DashboardContentCacheDuration
+		if duration.Duration <= 0 || shortest != nil && shortest.Duration <= duration.Duration {
+			continue
+		}
+		if imported, err := c.importedByLegacyGrafana(grafana, dashboard); err == nil && imported {
+			shortest = &duration
+		}
+	}
+	return shortest
+}
@@ -0,0 +1,125 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	v1beta1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/clientset/versioned/fake"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestLegacyGrafanaInstanceSelector(t *testing.T) {
+	legacyGrafana := func(name string, selector map[string]string) *v1alpha1.Grafana {
+		return &v1alpha1.Grafana{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "monitoring"},
+			Spec:       v1alpha1.GrafanaSpec{DashboardLabelSelector: []*metav1.LabelSelector{{MatchLabels: selector}}},
+		}
+	}
+	migratedGrafana := func(name string, labels map[string]string) *v1beta1.Grafana {
+		return &v1beta1.Grafana{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "monitoring", Labels: labels}}
+	}
+	controller := &ConverterController{
+		log:               logr.Discard(),
+		v1alpha1clientset: v1alpha1fake.NewSimpleClientset(),
+		v1beta1clientset:  v1beta1fake.NewSimpleClientset(),
+	}
+	conf := ConverterConfig{
+		InstanceSelectorMode: InstanceSelectorModeLegacyGrafana,
+		InstanceSelector:     &metav1.LabelSelector{MatchLabels: map[string]string{"app": "grafana-default"}},
+	}
+	controller.setConfig(conf)
+	informers, err := controller.newGrafanaInformers(conf)
+	require.NoError(t, err)
+	controller.grafanas = informers
+	addGrafanas := func(legacy *v1alpha1.Grafana, migrated *v1beta1.Grafana) {
+		require.NoError(t, informers.legacy[0].Integreatly().V1alpha1().Grafanas().Informer().GetStore().Add(legacy))
+		if migrated != nil {
+			require.NoError(t, informers.migrated[0].Observability().V1beta1().Grafanas().Informer().GetStore().Add(migrated))
+		}
+	}
+	addGrafanas(legacyGrafana("ops", map[string]string{"audience": "ops"}),
+		migratedGrafana("ops", map[string]string{"app": "grafana", "instance": "ops"}))
+	addGrafanas(legacyGrafana("business", map[string]string{"audience": "business"}),
+		migratedGrafana("business", map[string]string{"app": "grafana", "instance": "business"}))
+	addGrafanas(legacyGrafana("unmigrated", map[string]string{"audience": "tenant"}), nil)
+	dashboard := func(labels map[string]string) *v1alpha1.GrafanaDashboard {
+		return &v1alpha1.GrafanaDashboard{ObjectMeta: metav1.ObjectMeta{Name: "sample-dashboard", Namespace: "product-a", Labels: labels}}
+	}
+
+	selector, err := controller.instanceSelector(conf, v1alpha1.GrafanaDashboardKind, dashboard(map[string]string{"audience": "business"}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app": "grafana", "instance": "business"}, selector.MatchLabels)
+
+	selector, err = controller.instanceSelector(conf, v1alpha1.GrafanaDashboardKind, dashboard(map[string]string{"audience": "ops"}))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app": "grafana", "instance": "ops"}, selector.MatchLabels)
+
+	selector, err = controller.instanceSelector(conf, v1alpha1.GrafanaDashboardKind, dashboard(nil))
+	require.NoError(t, err)
+	assert.Equal(t, conf.InstanceSelector, selector, "objects no legacy Grafana imports get the configured instance selector")
+
+	err = controller.place(v1alpha1.GrafanaDashboardKind, dashboard(map[string]string{"audience": "tenant"})).err
+	assert.ErrorContains(t, err, "legacy Grafana monitoring/unmigrated which imports the object is not migrated")
+
+	selector, err = controller.instanceSelector(conf, v1alpha1.GrafanaFolderKind, &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "shared", Namespace: "product-a", Labels: map[string]string{"audience": "ops"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, conf.InstanceSelector, selector, "legacy Grafanas select only dashboards")
+
+	selector, err = controller.instanceSelector(conf, v1alpha1.GrafanaDataSourceKind, &v1alpha1.GrafanaDataSource{
+		ObjectMeta: metav1.ObjectMeta{Name: "prometheus", Namespace: "monitoring"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, conf.InstanceSelector, selector, "datasources are not routed to the Grafana of their namespace")
+
+	addGrafanas(legacyGrafana("shared", map[string]string{"audience": "ops"}),
+		migratedGrafana("shared", map[string]string{"app": "grafana", "instance": "shared"}))
+	_, err = controller.instanceSelector(conf, v1alpha1.GrafanaDashboardKind, dashboard(map[string]string{"audience": "ops"}))
+	assert.ErrorContains(t, err, "would select Grafana monitoring/business", "a selector of several Grafanas must not select other ones")
+}
+
+func TestLegacyGrafanasAreWatchedOnlyWhenNeeded(t *testing.T) {
+	sourceClient := v1alpha1fake.NewSimpleClientset()
+	controller := &ConverterController{
+		log:               logr.Discard(),
+		v1alpha1clientset: sourceClient,
+		v1beta1clientset:  v1beta1fake.NewSimpleClientset(),
+	}
+	dashboards := ConverterConfig{Enable: true, EnabledGrafanaConverter: EnabledGrafanaConverter{Dashboard: true}}
+	informers, err := controller.newGrafanaInformers(dashboards)
+	require.NoError(t, err)
+	assert.Nil(t, informers, "dashboards without a content cache default do not need legacy Grafanas")
+
+	withDefault := dashboards
+	withDefault.Defaults.Dashboard.ContentCacheDuration = &metav1.Duration{Duration: time.Hour}
+	informers, err = controller.newGrafanaInformers(withDefault)
+	require.NoError(t, err)
+	require.NotNil(t, informers)
+	assert.Len(t, informers.legacy, 1)
+
+	// installs without the legacy Grafana CRD or without permissions to list legacy Grafanas
+	for _, listErr := range []error{
+		apierrors.NewNotFound(schema.GroupResource{Group: v1alpha1.GroupVersion.Group, Resource: "grafanas"}, ""),
+		apierrors.NewForbidden(schema.GroupResource{Group: v1alpha1.GroupVersion.Group, Resource: "grafanas"}, "", nil),
+	} {
+		sourceClient.PrependReactor("list", "grafanas", func(clienttesting.Action) (bool, runtime.Object, error) {
+			return true, nil, listErr
+		})
+		informers, err = controller.newGrafanaInformers(ConverterConfig{InstanceSelectorMode: InstanceSelectorModeLegacyGrafana})
+		require.NoError(t, err)
+		require.NotNil(t, informers)
+		assert.Empty(t, informers.legacy, "legacy Grafanas which can not be listed are not watched, so their caches never block")
+		assert.Len(t, informers.migrated, 1)
+	}
+}
@@ -1,32 +1,136 @@
 package controllers
 
 import (
+	"errors"
+	"fmt"
 	"maps"
+	"slices"
+	"strings"
 
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
 )
 
 const (
 	managedByOperatorLabelKey   = "app.kubernetes.io/managed-by-operator"
 	managedByOperatorLabelValue = "grafana-operator-converter"
+
+	// converterAnnotationPrefix is reserved for annotations maintained by the converter
+	converterAnnotationPrefix = "grafana-converter.qubership.org/"
+	// sourceNameAnnotationKey keeps the name of the v1alpha1 object a converted object was produced from
+	sourceNameAnnotationKey = converterAnnotationPrefix + "source-name"
 )
 
-func convertedObjectMeta(source metav1.Object, name string) metav1.ObjectMeta {
-	labels := maps.Clone(source.GetLabels())
-	if labels == nil {
-		labels = make(map[string]string, 1)
-	}
+// convertedObjectMeta returns metadata of the object converted from the v1alpha1 object,
+// labels and annotations of the source are copied according to the propagation rules
+func (c *ConverterController) convertedObjectMeta(source metav1.Object, namespace, name string, propagation Propagation) metav1.ObjectMeta {
+	labels := propagation.labels(source.GetLabels())
 	labels[managedByOperatorLabelKey] = managedByOperatorLabelValue
 
-	return metav1.ObjectMeta{
-		Namespace:       source.GetNamespace(),
-		Name:            name,
-		Labels:          labels,
-		Annotations:     maps.Clone(source.GetAnnotations()),
-		OwnerReferences: append([]metav1.OwnerReference(nil), source.GetOwnerReferences()...),
+	annotations := propagation.annotations(source.GetAnnotations())
+	maps.DeleteFunc(annotations, func(key, _ string) bool {
+		return strings.HasPrefix(key, converterAnnotationPrefix)
+	})
+	annotations[sourceNameAnnotationKey] = source.GetName()
+
+	meta := metav1.ObjectMeta{
+		Namespace:   namespace,
+		Name:        name,
+		Labels:      labels,
+		Annotations: annotations,
+	}
+	if c.ownerReferencesAllowed(source, meta.Namespace) {
+		meta.OwnerReferences = append([]metav1.OwnerReference(nil), source.GetOwnerReferences()...)
+	}
+	return meta
+}
+
+// validateLabels returns problems of labels the configuration adds to converted objects
+func validateLabels(field string, labels map[string]string) []error {
+	var errs []error
+	// keys are sorted, so errors are reported in the same order
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		for _, msg := range append(validation.IsQualifiedName(key), validation.IsValidLabelValue(labels[key])...) {
+			errs = append(errs, fmt.Errorf("%s: %q: %s", field, key, msg))
+		}
+		if key == managedByOperatorLabelKey {
+			errs = append(errs, fmt.Errorf("%s: %q is reserved for the converter", field, key))
+		}
+	}
+	return errs
+}
+
+// validateAnnotations returns problems of annotations the configuration adds to converted objects
+func validateAnnotations(field string, annotations map[string]string) []error {
+	var errs []error
+	for _, key := range slices.Sorted(maps.Keys(annotations)) {
+		for _, msg := range validation.IsQualifiedName(key) {
+			errs = append(errs, fmt.Errorf("%s: %q: %s", field, key, msg))
+		}
+		if strings.HasPrefix(key, converterAnnotationPrefix) {
+			errs = append(errs, fmt.Errorf("%s: %q is reserved for the converter", field, key))
+		}
 	}
+	return errs
+}
+
+// ownerReferencesAllowed reports whether objects converted from the v1alpha1 object into the namespace may refer
+// to owners of the source, owner references can not point to objects in other namespaces or clusters
+func (c *ConverterController) ownerReferencesAllowed(source metav1.Object, namespace string) bool {
+	return !c.separateTargetCluster && namespace == source.GetNamespace()
 }
 
+// errNotManaged is returned for existing objects the converter must not change
+var errNotManaged = errors.New("resource is not managed by the converter")
+
 func isConverterManaged(object metav1.Object) bool {
 	return object.GetLabels()[managedByOperatorLabelKey] == managedByOperatorLabelValue
 }
+
+// onlyStatusChanged reports whether the update of the object kept its spec generation and metadata
+func onlyStatusChanged(old, new metav1.Object) bool {
+	return old.GetGeneration() == new.GetGeneration() &&
+		maps.Equal(old.GetLabels(), new.GetLabels()) &&
+		maps.Equal(old.GetAnnotations(), new.GetAnnotations())
+}
+
+// sourceNameOf returns the name of the v1alpha1 object the converted object was produced from.
+// Objects converted before the source annotation was introduced do not carry it.
+func sourceNameOf(object metav1.Object) (string, bool) {
+	name, ok := object.GetAnnotations()[sourceNameAnnotationKey]
+	return name, ok
+}
+
+// sourceNamespaceOf returns the namespace of the v1alpha1 object the converted object was produced from,
+// objects converted before the provenance annotations were introduced are in the namespace of their source
+func sourceNamespaceOf(object metav1.Object) string {
+	if namespace := object.GetAnnotations()[sourceNamespaceAnnotationKey]; namespace != "" {
+		return namespace
+	}
+	return object.GetNamespace()
+}
+
+// setSourceOwnerReference makes the v1alpha1 source the controller owner of the converted object,
+// so Kubernetes garbage collection removes the converted object together with its source
+func (c *ConverterController) setSourceOwnerReference(meta *metav1.ObjectMeta, source metav1.Object, kind string) {
+	if !c.ownerReferencesAllowed(source, meta.Namespace) {
+		// objects converted into another namespace or cluster are removed by the converter only
+		return
+	}
+	hasController := false
+	for _, ref := range meta.OwnerReferences {
+		if ref.UID == source.GetUID() {
+			return
+		}
+		hasController = hasController || ptr.Deref(ref.Controller, false)
+	}
+	meta.OwnerReferences = append(meta.OwnerReferences, metav1.OwnerReference{
+		APIVersion: v1alpha1.GroupVersion.String(),
+		Kind:       kind,
+		Name:       source.GetName(),
+		UID:        source.GetUID(),
+		Controller: ptr.To(!hasController),
+	})
+}
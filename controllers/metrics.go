@@ -0,0 +1,236 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// operationConvert converts an existing v1alpha1 object, operationDelete propagates the deletion of one
+	operationConvert = "convert"
+	operationDelete  = "delete"
+
+	resultSuccess = "success"
+	resultError   = "error"
+)
+
+var (
+	conversionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grafana_converter_conversions_total",
+		Help: "Number of processed v1alpha1 objects by kind, operation and result.",
+	}, []string{"kind", "operation", "result"})
+	conversionDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grafana_converter_conversion_duration_seconds",
+		Help:    "Time spent processing a v1alpha1 object by kind and operation.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"kind", "operation"})
+	apiRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grafana_converter_api_request_duration_seconds",
+		Help:    "Latency of Kubernetes API requests made by the converter by resource and verb.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"resource", "verb"})
+	filteredSourcesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "grafana_converter_filtered_sources_total",
+		Help: "Number of v1alpha1 objects not converted by kind and the source selector or source filter they did not pass.",
+	}, []string{"kind", "filter"})
+
+	sourcesDesc = prometheus.NewDesc(
+		"grafana_converter_sources",
+		"Number of v1alpha1 objects by kind and namespace.",
+		[]string{"kind", "namespace"}, nil,
+	)
+	convertedObjectsDesc = prometheus.NewDesc(
+		"grafana_converter_converted_objects",
+		"Number of v1beta1 objects converted from v1alpha1 objects by source kind and namespace.",
+		[]string{"kind", "namespace"}, nil,
+	)
+	failedSourcesDesc = prometheus.NewDesc(
+		"grafana_converter_failed_sources",
+		"Number of v1alpha1 objects whose last conversion failed by kind and namespace.",
+		[]string{"kind", "namespace"}, nil,
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(conversionsTotal, conversionDuration, apiRequestDuration, filteredSourcesTotal)
+}
+
+// observeConversion records the result and the duration of the operation on a v1alpha1 object of the kind
+func observeConversion(kind, operation string, start time.Time, err error) {
+	result := resultSuccess
+	if err != nil {
+		result = resultError
+	}
+	conversionsTotal.WithLabelValues(kind, operation, result).Inc()
+	conversionDuration.WithLabelValues(kind, operation).Observe(time.Since(start).Seconds())
+}
+
+// sourceState is the conversion state of one v1alpha1 object
+type sourceState struct {
+	namespace string
+	status    *v1alpha1.ConversionStatus
+}
+
+// sourceStates returns conversion states of v1alpha1 objects of the kind from informer caches
+func (c *ConverterController) sourceStates(kind string) ([]sourceState, error) {
+	var states []sourceState
+	for _, informerFactory := range c.sourceInformers() {
+		informers := informerFactory.Integreatly().V1alpha1()
+		switch kind {
+		case v1alpha1.GrafanaDashboardKind:
+			dashboards, err := informers.GrafanaDashboards().Lister().List(labels.Everything())
+			if err != nil {
+				return nil, err
+			}
+			for _, dashboard := range dashboards {
+				states = append(states, sourceState{dashboard.Namespace, dashboard.Status.Conversion})
+			}
+		case v1alpha1.GrafanaDataSourceKind:
+			datasources, err := informers.GrafanaDataSources().Lister().List(labels.Everything())
+			if err != nil {
+				return nil, err
+			}
+			for _, datasource := range datasources {
+				states = append(states, sourceState{datasource.Namespace, datasource.Status.Conversion})
+			}
+		case v1alpha1.GrafanaFolderKind:
+			folders, err := informers.GrafanaFolders().Lister().List(labels.Everything())
+			if err != nil {
+				return nil, err
+			}
+			for _, folder := range folders {
+				states = append(states, sourceState{folder.Namespace, folder.Status.Conversion})
+			}
+		case v1alpha1.GrafanaNotificationChannelKind:
+			channels, err := informers.GrafanaNotificationChannels().Lister().List(labels.Everything())
+			if err != nil {
+				return nil, err
+			}
+			for _, channel := range channels {
+				states = append(states, sourceState{channel.Namespace, channel.Status.Conversion})
+			}
+		}
+	}
+	return states, nil
+}
+
+// sourceCollector reports gauges of v1alpha1 objects and their conversion state on every scrape,
+// the state is read from informer caches and the conversion status written by the converter
+type sourceCollector struct {
+	c *ConverterController
+}
+
+func (s sourceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- sourcesDesc
+	ch <- convertedObjectsDesc
+	ch <- failedSourcesDesc
+}
+
+func (s sourceCollector) Collect(ch chan<- prometheus.Metric) {
+	// only kinds with a queue are converted, informers of other kinds are never started
+	for _, kind := range s.c.queuedKinds() {
+		states, err := s.c.sourceStates(kind)
+		if err != nil {
+			s.c.log.Error(err, "cannot collect metrics of sources", "kind", kind)
+			continue
+		}
+		sources, converted, failed := map[string]int{}, map[string]int{}, map[string]int{}
+		for _, state := range states {
+			sources[state.namespace]++
+			if state.status == nil {
+				continue
+			}
+			switch state.status.Phase {
+			case v1alpha1.ConversionPhaseConverted:
+				converted[state.namespace] += len(state.status.ConvertedObjects)
+			case v1alpha1.ConversionPhaseFailed:
+				failed[state.namespace]++
+			}
+		}
+		for namespace, count := range sources {
+			ch <- prometheus.MustNewConstMetric(sourcesDesc, prometheus.GaugeValue, float64(count), kind, namespace)
+			ch <- prometheus.MustNewConstMetric(convertedObjectsDesc, prometheus.GaugeValue, float64(converted[namespace]), kind, namespace)
+			ch <- prometheus.MustNewConstMetric(failedSourcesDesc, prometheus.GaugeValue, float64(failed[namespace]), kind, namespace)
+		}
+	}
+}
+
+// InstrumentRoundTripper measures latency of Kubernetes API requests made through the round tripper,
+// it is meant for rest.Config.Wrap of the clients passed to the converter
+func InstrumentRoundTripper(rt http.RoundTripper) http.RoundTripper {
+	return &instrumentedRoundTripper{next: rt}
+}
+
+type instrumentedRoundTripper struct {
+	next http.RoundTripper
+}
+
+func (rt *instrumentedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// watches last until they are closed, their duration is not a latency
+	if req.URL.Query().Get("watch") == "true" {
+		return rt.next.RoundTrip(req)
+	}
+	start := time.Now()
+	resp, err := rt.next.RoundTrip(req)
+	resource, named := apiResource(req.URL.Path)
+	apiRequestDuration.WithLabelValues(resource, apiVerb(req, named)).Observe(time.Since(start).Seconds())
+	return resp, err
+}
+
+// apiResource returns the resource.group and the subresource of the API path and whether the path refers to a single object,
+// e.g. grafanadashboards.integreatly.org/status for /apis/integreatly.org/v1alpha1/namespaces/ns/grafanadashboards/name/status
+func apiResource(path string) (string, bool) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	var group string
+	switch {
+	case len(parts) > 2 && parts[0] == "apis":
+		group, parts = parts[1], parts[3:]
+	case len(parts) > 1 && parts[0] == "api":
+		parts = parts[2:]
+	default:
+		return "unknown", false
+	}
+	if len(parts) > 2 && parts[0] == "namespaces" {
+		parts = parts[2:]
+	}
+	if len(parts) == 0 {
+		return "unknown", false
+	}
+	resource := parts[0]
+	if group != "" {
+		resource += "." + group
+	}
+	if len(parts) > 2 {
+		resource += "/" + parts[2]
+	}
+	return resource, len(parts) > 1
+}
+
+// apiVerb returns the Kubernetes API verb of the request
+func apiVerb(req *http.Request, named bool) string {
+	switch req.Method {
+	case http.MethodGet:
+		if named {
+			return "get"
+		}
+		return "list"
+	case http.MethodPost:
+		return "create"
+	case http.MethodPut:
+		return "update"
+	case http.MethodPatch:
+		if strings.HasPrefix(req.Header.Get("Content-Type"), "application/apply-patch") {
+			return "apply"
+		}
+		return "patch"
+	case http.MethodDelete:
+		return "delete"
+	}
+	return strings.ToLower(req.Method)
+}
@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	v1alpha1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSyncGrafanaFolderCountsConversions(t *testing.T) {
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"},
+		Spec:       v1alpha1.GrafanaFolderSpec{FolderName: "converted"},
+	}
+	sourceClient := v1alpha1fake.NewSimpleClientset(source)
+	informerFactory := v1alpha1informers.NewSharedInformerFactory(sourceClient, 0)
+	store := informerFactory.Integreatly().V1alpha1().GrafanaFolders().Informer().GetStore()
+	require.NoError(t, store.Add(source))
+	controller := &ConverterController{
+		log:                     logr.Discard(),
+		v1alpha1clientset:       sourceClient,
+		v1beta1clientset:        newFakeV1beta1Clientset(),
+		v1alpha1InformerFactory: []v1alpha1informers.SharedInformerFactory{informerFactory},
+	}
+	converted := testutil.ToFloat64(conversionsTotal.WithLabelValues(v1alpha1.GrafanaFolderKind, operationConvert, resultSuccess))
+	deleted := testutil.ToFloat64(conversionsTotal.WithLabelValues(v1alpha1.GrafanaFolderKind, operationDelete, resultSuccess))
+
+	require.NoError(t, controller.syncGrafanaFolder(context.Background(), "product-a/sample-folder"))
+	require.NoError(t, store.Delete(source))
+	require.NoError(t, controller.syncGrafanaFolder(context.Background(), "product-a/sample-folder"))
+
+	assert.Equal(t, converted+1, testutil.ToFloat64(conversionsTotal.WithLabelValues(v1alpha1.GrafanaFolderKind, operationConvert, resultSuccess)))
+	assert.Equal(t, deleted+1, testutil.ToFloat64(conversionsTotal.WithLabelValues(v1alpha1.GrafanaFolderKind, operationDelete, resultSuccess)))
+}
+
+func TestSourceCollectorReportsConversionStatePerNamespace(t *testing.T) {
+	informerFactory := v1alpha1informers.NewSharedInformerFactory(v1alpha1fake.NewSimpleClientset(), 0)
+	store := informerFactory.Integreatly().V1alpha1().GrafanaDataSources().Informer().GetStore()
+	for _, datasource := range []*v1alpha1.GrafanaDataSource{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "converted", Namespace: "product-a"},
+			Status: v1alpha1.GrafanaDataSourceStatus{Conversion: &v1alpha1.ConversionStatus{
+				Phase:            v1alpha1.ConversionPhaseConverted,
+				ConvertedObjects: []string{"product-a-loki", "product-a-tempo"},
+			}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "failed", Namespace: "product-a"},
+			Status: v1alpha1.GrafanaDataSourceStatus{Conversion: &v1alpha1.ConversionStatus{
+				Phase:            v1alpha1.ConversionPhaseFailed,
+				ConvertedObjects: []string{"product-a-prometheus"},
+			}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "product-b"},
+		},
+	} {
+		require.NoError(t, store.Add(datasource))
+	}
+	controller := &ConverterController{
+		log:                     logr.Discard(),
+		v1alpha1InformerFactory: []v1alpha1informers.SharedInformerFactory{informerFactory},
+		queues:                  map[string]*kindQueue{v1alpha1.GrafanaDataSourceKind: nil},
+	}
+
+	expected := `
+# HELP grafana_converter_converted_objects Number of v1beta1 objects converted from v1alpha1 objects by source kind and namespace.
+# TYPE grafana_converter_converted_objects gauge
+grafana_converter_converted_objects{kind="GrafanaDataSource",namespace="product-a"} 2
+grafana_converter_converted_objects{kind="GrafanaDataSource",namespace="product-b"} 0
+# HELP grafana_converter_failed_sources Number of v1alpha1 objects whose last conversion failed by kind and namespace.
+# TYPE grafana_converter_failed_sources gauge
+grafana_converter_failed_sources{kind="GrafanaDataSource",namespace="product-a"} 1
+grafana_converter_failed_sources{kind="GrafanaDataSource",namespace="product-b"} 0
+# HELP grafana_converter_sources Number of v1alpha1 objects by kind and namespace.
+# TYPE grafana_converter_sources gauge
+grafana_converter_sources{kind="GrafanaDataSource",namespace="product-a"} 2
+grafana_converter_sources{kind="GrafanaDataSource",namespace="product-b"} 1
+`
+	assert.NoError(t, testutil.CollectAndCompare(sourceCollector{controller}, strings.NewReader(expected)))
+}
+
+func TestAPIRequestResourceAndVerb(t *testing.T) {
+	for _, tc := range []struct {
+		method      string
+		path        string
+		contentType string
+		resource    string
+		verb        string
+	}{
+		{method: http.MethodGet, path: "/apis/integreatly.org/v1alpha1/grafanadashboards", resource: "grafanadashboards.integreatly.org", verb: "list"},
+		{method: http.MethodGet, path: "/apis/grafana.integreatly.org/v1beta1/namespaces/ns/grafanafolders/sample", resource: "grafanafolders.grafana.integreatly.org", verb: "get"},
+		{method: http.MethodPut, path: "/apis/integreatly.org/v1alpha1/namespaces/ns/grafanafolders/sample/status", resource: "grafanafolders.integreatly.org/status", verb: "update"},
+		{method: http.MethodPatch, path: "/apis/grafana.integreatly.org/v1beta1/namespaces/ns/grafanadashboards/sample", contentType: "application/apply-patch+yaml", resource: "grafanadashboards.grafana.integreatly.org", verb: "apply"},
+		{method: http.MethodPatch, path: "/apis/integreatly.org/v1alpha1/namespaces/ns/grafanadashboards/sample", contentType: "application/merge-patch+json", resource: "grafanadashboards.integreatly.org", verb: "patch"},
+		{method: http.MethodGet, path: "/api/v1/namespaces/ns", resource: "namespaces", verb: "get"},
+		{method: http.MethodDelete, path: "/api/v1/namespaces/ns/configmaps/sample", resource: "configmaps", verb: "delete"},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			req, err := http.NewRequest(tc.method, "https://kubernetes"+tc.path, nil)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", tc.contentType)
+
+			resource, named := apiResource(req.URL.Path)
+
+			assert.Equal(t, tc.resource, resource)
+			assert.Equal(t, tc.verb, apiVerb(req, named))
+		})
+	}
+}
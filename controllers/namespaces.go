@@ -0,0 +1,444 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	v1alpha1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions"
+	v1beta1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/informers/externalversions"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	// WatchNamespaceSelectorEnvVar is the constant for env variable WATCH_NAMESPACE_SELECTOR
+	// which specifies the label selector of namespaces to watch, e.g. "environment=dev" or "environment: dev".
+	// Namespaces are watched while they match it, it is ignored if WATCH_NAMESPACE is set.
+	WatchNamespaceSelectorEnvVar = "WATCH_NAMESPACE_SELECTOR"
+	// WatchNamespaceExcludeEnvVar is the constant for env variable WATCH_NAMESPACE_EXCLUDE
+	// which specifies the comma-separated namespaces that are never watched.
+	WatchNamespaceExcludeEnvVar = "WATCH_NAMESPACE_EXCLUDE"
+)
+
+// namespaceFilter selects watched namespaces by their labels
+type namespaceFilter struct {
+	selector labels.Selector
+	exclude  []string
+}
+
+// getNamespaceFilter returns the filter of watched namespaces from env variables, or nil if all namespaces are watched
+func getNamespaceFilter() (*namespaceFilter, error) {
+	selector, _ := os.LookupEnv(WatchNamespaceSelectorEnvVar)
+	exclude, _ := os.LookupEnv(WatchNamespaceExcludeEnvVar)
+	if strings.TrimSpace(selector) == "" && strings.TrimSpace(exclude) == "" {
+		return nil, nil
+	}
+
+	filter := &namespaceFilter{selector: labels.Everything()}
+	if strings.TrimSpace(selector) != "" {
+		var err error
+		if filter.selector, err = ParseNamespaceSelector(selector); err != nil {
+			return nil, fmt.Errorf("incorrect namespace selector for env var=%q: %w", WatchNamespaceSelectorEnvVar, err)
+		}
+	}
+	for _, ns := range strings.Split(exclude, ",") {
+		if ns = strings.TrimSpace(ns); ns == "" {
+			continue
+		}
+		if !validNamespaceRegex.MatchString(ns) {
+			return nil, fmt.Errorf("incorrect namespace name=%q for env var=%q with value: %q must match regex: %q", ns, WatchNamespaceExcludeEnvVar, exclude, validNamespaceRegex.String())
+		}
+		filter.exclude = append(filter.exclude, ns)
+	}
+	return filter, nil
+}
+
+// ParseNamespaceSelector parses a label selector of namespaces,
+// the legacy "key: value" form of WATCH_NAMESPACE_SELECTOR is accepted as well
+func ParseNamespaceSelector(selector string) (labels.Selector, error) {
+	if key, value, ok := strings.Cut(selector, ":"); ok && !strings.ContainsAny(selector, "=!(,") {
+		selector = strings.TrimSpace(key) + "=" + strings.TrimSpace(value)
+	}
+	return labels.Parse(selector)
+}
+
+// excludes reports whether the namespace is never watched
+func (f *namespaceFilter) excludes(namespace string) bool {
+	return f != nil && slices.Contains(f.exclude, namespace)
+}
+
+// matches reports whether the namespace is watched
+func (f *namespaceFilter) matches(namespace *corev1.Namespace) bool {
+	return !f.excludes(namespace.Name) && f.selector.Matches(labels.Set(namespace.Labels))
+}
+
+// selectsNamespaces reports whether watched namespaces follow namespace labels,
+// a list of namespaces in WATCH_NAMESPACE takes precedence over the selector
+func (c *ConverterController) selectsNamespaces() bool {
+	return c.namespaceFilter != nil && len(mustGetWatchNamespaces()) == 0
+}
+
+// watchedNamespaces returns namespaces whose v1alpha1 objects are converted, metav1.NamespaceAll stands for all namespaces
+func (c *ConverterController) watchedNamespaces() []string {
+	if c.selectsNamespaces() {
+		c.mu.RLock()
+		defer c.mu.RUnlock()
+		namespaces := make([]string, 0, len(c.selectedNamespaces))
+		for ns := range c.selectedNamespaces {
+			namespaces = append(namespaces, ns)
+		}
+		slices.Sort(namespaces)
+		return namespaces
+	}
+
+	namespaces := mustGetWatchNamespaces()
+	if len(namespaces) == 0 {
+		return []string{metav1.NamespaceAll}
+	}
+	return slices.DeleteFunc(slices.Clone(namespaces), c.namespaceFilter.excludes)
+}
+
+// watchesNamespace reports whether v1alpha1 objects of the namespace are watched by the current informers
+func (c *ConverterController) watchesNamespace(namespace string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	_, all := c.namespaceInformers[metav1.NamespaceAll]
+	_, ok := c.namespaceInformers[namespace]
+	return all || ok
+}
+
+// inWatchedNamespaces skips keys of namespaces which are no longer watched, their sources are not deleted,
+// so converted objects are left as they are. Keys of selected namespaces whose informers are being started are retried.
+// Keys of namespaces whose overrides disable the kind are skipped the same way.
+func (c *ConverterController) inWatchedNamespaces(kind string, sync syncFunc) syncFunc {
+	return func(ctx context.Context, key string) error {
+		namespace, _, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return sync(ctx, key)
+		}
+		if !c.watchesNamespace(namespace) {
+			c.mu.RLock()
+			selected := c.selectedNamespaces[namespace]
+			c.mu.RUnlock()
+			if selected {
+				return fmt.Errorf("informers of namespace %s are being started", namespace)
+			}
+			return nil
+		}
+		conf, _, err := c.namespaceConfig(namespace)
+		if err != nil {
+			// objects of the namespace are enqueued again when its overrides are fixed
+			return permanent(err)
+		}
+		if !c.convertsKind(conf, kind) {
+			return nil
+		}
+		return sync(ctx, key)
+	}
+}
+
+// watchNamespaces starts the informer of namespaces which reads converter overrides of namespaces
+// and selects watched namespaces by their labels. Informers of a namespace are started when it starts
+// matching the selector and stopped when it stops matching. Namespaces are not watched when the converter
+// watches a list of namespaces or can not list namespaces, annotations of namespaces are ignored then.
+func (c *ConverterController) watchNamespaces(ctx context.Context) error {
+	if c.kubeclientset == nil {
+		if c.selectsNamespaces() {
+			return fmt.Errorf("namespaces can not be selected by labels without kubernetes client")
+		}
+		return nil
+	}
+	if len(mustGetWatchNamespaces()) > 0 {
+		return nil
+	}
+
+	var selector string
+	if c.selectsNamespaces() {
+		selector = c.namespaceFilter.selector.String()
+	}
+	if _, err := c.kubeclientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: selector, Limit: 1}); err != nil {
+		if apierrors.IsForbidden(err) && !c.selectsNamespaces() {
+			c.log.Info("namespaces can not be listed, converter overrides in namespace annotations are ignored", "reason", err.Error())
+			return nil
+		}
+		return fmt.Errorf("cannot list namespaces: %w", err)
+	}
+
+	factory := kubeinformers.NewSharedInformerFactoryWithOptions(c.kubeclientset, 0,
+		kubeinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = selector
+		}))
+	update := func(obj interface{}) {
+		namespace, ok := sourceFromTombstone(obj).(*corev1.Namespace)
+		if !ok || !c.selectsNamespaces() {
+			return
+		}
+		if namespace.DeletionTimestamp == nil && c.namespaceFilter.matches(namespace) {
+			c.selectNamespace(namespace.Name)
+		} else {
+			c.unselectNamespace(namespace.Name)
+		}
+	}
+	_, err := factory.Core().V1().Namespaces().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: update,
+		UpdateFunc: func(old, new interface{}) {
+			update(new)
+			oldNamespace, oldOk := old.(*corev1.Namespace)
+			newNamespace, newOk := new.(*corev1.Namespace)
+			if oldOk && newOk && oldNamespace.Annotations[namespaceConfigAnnotationKey] != newNamespace.Annotations[namespaceConfigAnnotationKey] &&
+				c.watchesNamespace(newNamespace.Name) {
+				c.namespaceOverrideChanged(newNamespace.Name)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if namespace, ok := sourceFromTombstone(obj).(*corev1.Namespace); ok && c.selectsNamespaces() {
+				c.unselectNamespace(namespace.Name)
+			}
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("cannot watch namespaces: %w", err)
+	}
+	c.namespaceLister = factory.Core().V1().Namespaces().Lister()
+
+	factory.Start(ctx.Done())
+	for _, ok := range factory.WaitForCacheSync(ctx.Done()) {
+		if !ok {
+			return fmt.Errorf("namespaces informer cache is not synced")
+		}
+	}
+	return nil
+}
+
+// selectNamespace starts informers of the namespace which started matching the namespace selector. Informers are
+// synced in the background without holding locks, so a slow or forbidden namespace does not stall other namespaces
+// and reloads. They are dropped if a reload replaced informers or the namespace was unselected meanwhile.
+func (c *ConverterController) selectNamespace(namespace string) {
+	// the namespace is selected under watchMu, so a concurrent watch either sees it or is finished
+	c.watchMu.Lock()
+	c.mu.Lock()
+	if c.selectedNamespaces[namespace] {
+		c.mu.Unlock()
+		c.watchMu.Unlock()
+		return
+	}
+	c.selectedNamespaces[namespace] = true
+	informersCtx, conf, queues := c.informersCtx, c.informersConf, c.queues
+	// informers of all selected namespaces are created by watch, after oneShot conversion nothing is watched
+	if informersCtx == nil || informersCtx.Err() != nil || len(queues) == 0 {
+		c.mu.Unlock()
+		c.watchMu.Unlock()
+		return
+	}
+	startCtx, cancelStart := context.WithCancel(informersCtx)
+	if c.startingNamespaces == nil {
+		c.startingNamespaces = map[string]context.CancelFunc{}
+	}
+	c.startingNamespaces[namespace] = cancelStart
+	c.mu.Unlock()
+	c.watchMu.Unlock()
+
+	go func() {
+		c.log.Info("namespace matches the namespace selector, watching it", "ns", namespace)
+		informers, err := c.newNamespaceInformers(namespace, conf, queues)
+		if err != nil {
+			c.log.Error(err, "cannot watch grafana objects in namespace", "ns", namespace)
+			c.mu.Lock()
+			// a cancelled start is no longer registered, the namespace may be started again
+			if startCtx.Err() == nil {
+				delete(c.startingNamespaces, namespace)
+			}
+			c.mu.Unlock()
+			cancelStart()
+			return
+		}
+		informers.start(startCtx)
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if startCtx.Err() != nil || c.informersCtx != informersCtx {
+			// the namespace was unselected, or watch replaced informers of all selected namespaces
+			informers.stop()
+			cancelStart()
+			return
+		}
+		delete(c.startingNamespaces, namespace)
+		c.namespaceInformers[namespace] = informers
+		c.refreshInformers()
+	}()
+}
+
+// unselectNamespace stops informers of the namespace which stopped matching the namespace selector
+func (c *ConverterController) unselectNamespace(namespace string) {
+	c.watchMu.Lock()
+	defer c.watchMu.Unlock()
+	c.mu.Lock()
+	if !c.selectedNamespaces[namespace] {
+		c.mu.Unlock()
+		return
+	}
+	delete(c.selectedNamespaces, namespace)
+	if cancelStart, ok := c.startingNamespaces[namespace]; ok {
+		delete(c.startingNamespaces, namespace)
+		cancelStart()
+	}
+	informers := c.namespaceInformers[namespace]
+	delete(c.namespaceInformers, namespace)
+	c.refreshInformers()
+	c.mu.Unlock()
+
+	if informers != nil {
+		c.log.Info("namespace does not match the namespace selector any more, converted objects in it are left as they are", "ns", namespace)
+		informers.stop()
+	}
+}
+
+// namespaceInformers are informers of v1alpha1 sources and their converted objects in one namespace
+type namespaceInformers struct {
+	sources   v1alpha1informers.SharedInformerFactory
+	converted v1beta1informers.SharedInformerFactory
+	// overrides watches the ConfigMap with converter overrides, it is nil if ConfigMaps can not be listed
+	overrides     kubeinformers.SharedInformerFactory
+	registrations []cache.ResourceEventHandlerRegistration
+	// synced report by kind whether handlers delivered the initial state to queues
+	synced map[string][]cache.InformerSynced
+	stop   context.CancelFunc
+}
+
+// newNamespaceInformers creates informers of the namespace which enqueue objects of converted kinds to the queues
+func (c *ConverterController) newNamespaceInformers(namespace string, conf ConverterConfig, queues map[string]*kindQueue) (*namespaceInformers, error) {
+	informers := &namespaceInformers{
+		sources: v1alpha1informers.NewSharedInformerFactoryWithOptions(c.v1alpha1clientset, c.informersResyncPeriod(conf), v1alpha1informers.WithNamespace(namespace)),
+		synced:  map[string][]cache.InformerSynced{},
+	}
+	for _, kind := range c.convertedKinds(conf) {
+		queue, ok := queues[kind.kind]
+		if !ok {
+			continue
+		}
+		if err := selectSources(informers.sources, kind, namespace, conf.SourceSelector.of(kind.kind)); err != nil {
+			return nil, err
+		}
+		registration, err := kind.informer(informers.sources).AddEventHandler(queue.eventHandler())
+		if err != nil {
+			return nil, fmt.Errorf("cannot add %s handler: %w", kind.kind, err)
+		}
+		informers.registrations = append(informers.registrations, registration)
+		informers.synced[kind.kind] = append(informers.synced[kind.kind], registration.HasSynced)
+	}
+
+	if conf.driftPolicy() != DriftPolicyIgnore {
+		var err error
+		if informers.converted, err = c.watchConvertedObjects(namespace, queues, informers.synced); err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	if informers.overrides, err = c.watchOverrideConfigMaps(namespace); err != nil {
+		return nil, err
+	}
+	return informers, nil
+}
+
+// watchOverrideConfigMaps creates the informer of ConfigMaps with converter overrides in the namespace,
+// it returns nil if there is no kubernetes client or ConfigMaps can not be listed
+func (c *ConverterController) watchOverrideConfigMaps(namespace string) (kubeinformers.SharedInformerFactory, error) {
+	if c.kubeclientset == nil {
+		return nil, nil
+	}
+	fieldSelector := fields.OneTermEqualSelector("metadata.name", NamespaceConfigMapName).String()
+	if _, err := c.kubeclientset.CoreV1().ConfigMaps(namespace).List(c.ctx, metav1.ListOptions{FieldSelector: fieldSelector, Limit: 1}); err != nil {
+		if apierrors.IsForbidden(err) {
+			c.log.Info("ConfigMaps can not be listed, converter overrides in ConfigMaps are ignored", "ns", namespace, "reason", err.Error())
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot list ConfigMaps: %w", err)
+	}
+
+	factory := kubeinformers.NewSharedInformerFactoryWithOptions(c.kubeclientset, 0, kubeinformers.WithNamespace(namespace),
+		kubeinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.FieldSelector = fieldSelector
+		}))
+	changed := func(obj interface{}) {
+		if configMap, ok := sourceFromTombstone(obj).(*corev1.ConfigMap); ok {
+			c.namespaceOverrideChanged(configMap.Namespace)
+		}
+	}
+	_, err := factory.Core().V1().ConfigMaps().Informer().AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			// sources are enqueued by their own informers when informers of the namespace start
+			if !isInInitialList {
+				changed(obj)
+			}
+		},
+		UpdateFunc: func(_, new interface{}) { changed(new) },
+		DeleteFunc: changed,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot watch ConfigMaps: %w", err)
+	}
+	return factory, nil
+}
+
+// start starts informers and waits until their caches are synced, they are stopped by stop or when the context is done
+func (i *namespaceInformers) start(ctx context.Context) {
+	ctx, i.stop = context.WithCancel(ctx)
+	// overrides are synced first, so sources are converted with them
+	if i.overrides != nil {
+		i.overrides.Start(ctx.Done())
+		i.overrides.WaitForCacheSync(ctx.Done())
+	}
+	i.sources.Start(ctx.Done())
+	if i.converted != nil {
+		i.converted.Start(ctx.Done())
+	}
+	i.sources.WaitForCacheSync(ctx.Done())
+	if i.converted != nil {
+		i.converted.WaitForCacheSync(ctx.Done())
+	}
+}
+
+// refreshInformers updates informer factories, handler registrations and synced funcs of queues
+// after informers of a namespace or of a target namespace were replaced, the caller holds the lock
+func (c *ConverterController) refreshInformers() {
+	c.v1alpha1InformerFactory, c.v1beta1InformerFactory, c.handlerRegistrations = nil, nil, nil
+	synced := map[string][]cache.InformerSynced{}
+	namespaces := make([]string, 0, len(c.namespaceInformers))
+	for ns := range c.namespaceInformers {
+		namespaces = append(namespaces, ns)
+	}
+	slices.Sort(namespaces)
+	for _, ns := range namespaces {
+		informers := c.namespaceInformers[ns]
+		c.v1alpha1InformerFactory = append(c.v1alpha1InformerFactory, informers.sources)
+		if informers.converted != nil {
+			c.v1beta1InformerFactory = append(c.v1beta1InformerFactory, informers.converted)
+		}
+		c.handlerRegistrations = append(c.handlerRegistrations, informers.registrations...)
+		for kind, kindSynced := range informers.synced {
+			synced[kind] = append(synced[kind], kindSynced...)
+		}
+	}
+	targets := make([]string, 0, len(c.targetNamespaces))
+	for ns := range c.targetNamespaces {
+		targets = append(targets, ns)
+	}
+	slices.Sort(targets)
+	for _, ns := range targets {
+		if informers := c.targetNamespaces[ns]; informers.converted != nil {
+			c.v1beta1InformerFactory = append(c.v1beta1InformerFactory, informers.converted)
+		}
+	}
+	for kind, queue := range c.queues {
+		queue.synced = synced[kind]
+	}
+}
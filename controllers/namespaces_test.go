@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestParseNamespaceSelector(t *testing.T) {
+	for selector, want := range map[string]string{
+		"environment: dev":          "environment=dev",
+		"environment=dev":           "environment=dev",
+		"environment in (dev, qa)":  "environment in (dev,qa)",
+		"team,environment!=prod":    "environment!=prod,team",
+		"grafana-converter:enabled": "grafana-converter=enabled",
+	} {
+		parsed, err := ParseNamespaceSelector(selector)
+		require.NoError(t, err, selector)
+		assert.Equal(t, want, parsed.String(), selector)
+	}
+}
+
+func TestNamespaceFilterExcludesNamespaces(t *testing.T) {
+	t.Setenv(WatchNamespaceExcludeEnvVar, "kube-system, kube-public")
+	filter, err := getNamespaceFilter()
+	require.NoError(t, err)
+
+	assert.Equal(t, labels.Everything(), filter.selector)
+	assert.False(t, filter.matches(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}}))
+	assert.True(t, filter.matches(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "product-a"}}))
+}
+
+func TestNamespaceSelectorFollowsNamespaceLabels(t *testing.T) {
+	t.Setenv(WatchNamespaceSelectorEnvVar, "grafana-converter: enabled")
+	t.Setenv(WatchNamespaceExcludeEnvVar, "kube-system")
+	selected := map[string]string{"grafana-converter": "enabled"}
+	kubeClient := kubefake.NewClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "product-a", Labels: selected}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "product-b"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system", Labels: selected}},
+	)
+	path := filepath.Join(t.TempDir(), "parameters.yaml")
+	writeConfig(t, path, "enable: true\nfolder: true\n")
+	client := newFakeV1beta1Clientset()
+	controller, err := NewGrafanaConverterController(context.Background(), path, v1alpha1fake.NewSimpleClientset(
+		&v1alpha1.GrafanaFolder{ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"}},
+		&v1alpha1.GrafanaFolder{ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-b"}},
+		&v1alpha1.GrafanaFolder{ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "kube-system"}},
+	), client, nil, kubeClient, nil, 0, logr.Discard())
+	require.NoError(t, err)
+	converted := func(namespace string) bool {
+		_, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders(namespace).Get(context.Background(), "sample-folder", metav1.GetOptions{})
+		return err == nil
+	}
+
+	startController(t, controller)
+
+	require.Eventually(t, func() bool { return converted("product-a") }, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"product-a"}, controller.watchedNamespaces())
+	assert.False(t, converted("product-b"))
+	assert.False(t, converted("kube-system"), "excluded namespaces are never watched")
+
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "product-b", Labels: selected}}
+	_, err = kubeClient.CoreV1().Namespaces().Update(context.Background(), namespace, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return converted("product-b") }, 5*time.Second, 10*time.Millisecond)
+
+	namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "product-a"}}
+	_, err = kubeClient.CoreV1().Namespaces().Update(context.Background(), namespace, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		return !controller.watchesNamespace("product-a") && controller.watchesNamespace("product-b")
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"product-b"}, controller.watchedNamespaces())
+	assert.True(t, converted("product-a"), "converted objects of namespaces which are no longer watched are kept")
+}
+
+func TestForbiddenNamespaceDoesNotStallOtherNamespaces(t *testing.T) {
+	t.Setenv(WatchNamespaceSelectorEnvVar, "grafana-converter: enabled")
+	selected := map[string]string{"grafana-converter": "enabled"}
+	kubeClient := kubefake.NewClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "product-a", Labels: selected}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "product-b"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "forbidden"}},
+	)
+	sourceClient := v1alpha1fake.NewSimpleClientset(
+		&v1alpha1.GrafanaFolder{ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"}},
+		&v1alpha1.GrafanaFolder{ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-b"}},
+	)
+	// informers of the forbidden namespace never sync
+	sourceClient.PrependReactor("list", "grafanafolders", func(action clienttesting.Action) (bool, runtime.Object, error) {
+		if action.GetNamespace() == "forbidden" {
+			return true, nil, apierrors.NewForbidden(schema.GroupResource{Group: v1alpha1.GroupVersion.Group, Resource: "grafanafolders"}, "", nil)
+		}
+		return false, nil, nil
+	})
+	path := filepath.Join(t.TempDir(), "parameters.yaml")
+	writeConfig(t, path, "enable: true\nfolder: true\n")
+	client := newFakeV1beta1Clientset()
+	controller, err := NewGrafanaConverterController(context.Background(), path, sourceClient, client, nil, kubeClient, nil, 0, logr.Discard())
+	require.NoError(t, err)
+	converted := func(namespace string) bool {
+		_, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders(namespace).Get(context.Background(), "sample-folder", metav1.GetOptions{})
+		return err == nil
+	}
+	label := func(name string, labels map[string]string) {
+		_, err := kubeClient.CoreV1().Namespaces().Update(context.Background(), &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}, metav1.UpdateOptions{})
+		require.NoError(t, err)
+	}
+
+	startController(t, controller)
+	require.Eventually(t, func() bool { return converted("product-a") }, 5*time.Second, 10*time.Millisecond)
+
+	label("forbidden", selected)
+	label("product-b", selected)
+	assert.Eventually(t, func() bool { return converted("product-b") }, 5*time.Second, 10*time.Millisecond)
+	assert.False(t, controller.watchesNamespace("forbidden"))
+
+	label("forbidden", nil)
+	assert.Eventually(t, func() bool {
+		controller.mu.RLock()
+		defer controller.mu.RUnlock()
+		return len(controller.startingNamespaces) == 0 && !controller.selectedNamespaces["forbidden"]
+	}, 5*time.Second, 10*time.Millisecond, "informers being started are stopped when the namespace is unselected")
+}
@@ -2,136 +2,300 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"maps"
+	"time"
 
+	v1beta1ac "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/applyconfiguration/operator/v1beta1"
 	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
 	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
 	"github.com/go-logr/logr"
 	"github.com/grafana/grafana-openapi-client-go/models"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
-	"k8s.io/apimachinery/pkg/api/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
-	"k8s.io/utils/ptr"
+	"k8s.io/client-go/tools/cache"
 )
 
-// createGrafanaNotificationChannel converts GrafanaNotificationChannel v1alpha1 to v1beta1
-func (c *ConverterController) createGrafanaNotificationChannel(nc interface{}) {
-	notificationChannel, ok := nc.(*v1alpha1.GrafanaNotificationChannel)
-	if !ok {
-		c.log.Error(fmt.Errorf("type assertion failed"), "cannot cast to v1alpha1 GrafanaNotificationChannel")
-		return
+// getGrafanaNotificationChannel returns GrafanaNotificationChannel v1alpha1 from informer caches
+func (c *ConverterController) getGrafanaNotificationChannel(namespace, name string) (*v1alpha1.GrafanaNotificationChannel, error) {
+	for _, informerFactory := range c.sourceInformers() {
+		notificationChannel, err := informerFactory.Integreatly().V1alpha1().GrafanaNotificationChannels().Lister().GrafanaNotificationChannels(namespace).Get(name)
+		if err == nil || !apierrors.IsNotFound(err) {
+			return notificationChannel, err
+		}
 	}
+	return nil, apierrors.NewNotFound(v1alpha1.GroupVersion.WithResource("grafananotificationchannels").GroupResource(), name)
+}
 
-	l := c.log.WithValues("kind", v1alpha1.GrafanaNotificationChannelKind, "name", notificationChannel.Name, "ns", notificationChannel.Namespace)
-
-	cp, err := c.convertGrafanaNotificationChannel(notificationChannel)
+// syncGrafanaNotificationChannel converts GrafanaNotificationChannel v1alpha1 with the key to GrafanaContactPoint v1beta1
+// or propagates its deletion if it no longer exists
+func (c *ConverterController) syncGrafanaNotificationChannel(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
 	if err != nil {
-		l.Error(err, "cannot convert some GrafanaNotificationChannel at create")
-		return
+		c.log.Error(err, "cannot parse GrafanaNotificationChannel key", "key", key)
+		return nil
 	}
+	l := c.log.WithValues("kind", v1alpha1.GrafanaNotificationChannelKind, "name", name, "ns", namespace)
 
-	l.Info("start creating GrafanaContactPoint")
-	var createdContactPoint *v1beta1.GrafanaContactPoint
-	createdContactPoint, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaContactPoints(cp.Namespace).Create(context.Background(), cp, metav1.CreateOptions{})
+	start := time.Now()
+	notificationChannel, err := c.getGrafanaNotificationChannel(namespace, name)
 	if err != nil {
-		if errors.IsAlreadyExists(err) {
-			c.updateGrafanaNotificationChannel(nil, cp)
-			return
+		if apierrors.IsNotFound(err) {
+			if c.queue(v1alpha1.GrafanaNotificationChannelKind).isDriftOnly(key) {
+				// deletion of the source is handled when its own event is processed
+				return nil
+			}
+			if deselected, err := c.deselectedBySourceSelector(ctx, v1alpha1.GrafanaNotificationChannelKind, namespace, name); err != nil || deselected {
+				// the source stopped matching the source selector, objects converted from it are left as they are
+				return err
+			}
+			// the last known state is needed to find the object converted into another namespace or with another name
+			lastState, _ := c.queue(v1alpha1.GrafanaNotificationChannelKind).lastState(key)
+			deleted, ok := lastState.(*v1alpha1.GrafanaNotificationChannel)
+			if !ok {
+				deleted = &v1alpha1.GrafanaNotificationChannel{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+			}
+			err = c.deleteGrafanaNotificationChannel(ctx, l, deleted, c.place(v1alpha1.GrafanaNotificationChannelKind, deleted))
+			observeConversion(v1alpha1.GrafanaNotificationChannelKind, operationDelete, start, err)
+			return err
 		}
-		l.Error(err, "cannot create GrafanaContactPoint")
-		return
+		return err
+	}
+	passes, err := c.passesSourceFilter(v1alpha1.GrafanaNotificationChannelKind, notificationChannel)
+	if err != nil {
+		return permanent(fmt.Errorf("cannot evaluate source filter of GrafanaNotificationChannel: %w", err))
 	}
-	l.Info(fmt.Sprintf("GrafanaContactPoint %v/%v uid:%v has been created",
-		createdContactPoint.GetNamespace(),
-		createdContactPoint.GetName(),
-		createdContactPoint.GetUID()))
+	if !passes {
+		l.Info("GrafanaNotificationChannel does not pass the source filter, it is not converted")
+		return nil
+	}
+	p := c.place(v1alpha1.GrafanaNotificationChannelKind, notificationChannel)
+	control, err := controlOf(notificationChannel)
+	switch {
+	case err != nil:
+		err = permanent(fmt.Errorf("invalid control annotations of GrafanaNotificationChannel: %w", err))
+	case control.paused:
+		l.Info("GrafanaNotificationChannel is paused by annotation, it is not synced")
+		return nil
+	case control.skip:
+		l.Info("GrafanaNotificationChannel is skipped by annotation, it is not converted")
+		err = c.deleteGrafanaNotificationChannel(ctx, l, notificationChannel, p)
+		observeConversion(v1alpha1.GrafanaNotificationChannelKind, operationDelete, start, err)
+		return err
+	default:
+		err = c.reconcileGrafanaNotificationChannel(ctx, l, notificationChannel, p, c.reportsDrift(v1alpha1.GrafanaNotificationChannelKind, key))
+	}
+	c.recordConversionFailed(notificationChannel, "GrafanaContactPoint", p.namespace, []string{p.targetName(notificationChannel, name)}, err)
+	err = c.updateGrafanaNotificationChannelStatus(ctx, notificationChannel, p, err)
+	if err == nil {
+		err = c.recordGrafanaNotificationChannelReferences(ctx, l, notificationChannel, p)
+	}
+	observeConversion(v1alpha1.GrafanaNotificationChannelKind, operationConvert, start, err)
+	return err
 }
 
-// updateGrafanaNotificationChannel converts GrafanaNotificationChannel v1alpha1 to v1beta1
-func (c *ConverterController) updateGrafanaNotificationChannel(old, new interface{}) {
-	var notificationChannel *v1alpha1.GrafanaNotificationChannel
-	var contactPoint, existingContactPoint *v1beta1.GrafanaContactPoint
-	var ok bool
-	var l logr.Logger
-	var err error
-	notificationChannel, ok = new.(*v1alpha1.GrafanaNotificationChannel)
-	if ok && old != nil {
-		l = c.log.WithValues("kind", v1alpha1.GrafanaNotificationChannelKind, "name", notificationChannel.Name, "ns", notificationChannel.Namespace)
-		var notificationChannelOld *v1alpha1.GrafanaNotificationChannel
-		notificationChannelOld, ok = old.(*v1alpha1.GrafanaNotificationChannel)
-		if !ok {
-			c.log.Error(fmt.Errorf("type assertion failed"), "cannot cast to v1alpha1 GrafanaNotificationChannel")
-			return
-		}
+// reconcileGrafanaNotificationChannel creates or updates GrafanaContactPoint v1beta1 converted from GrafanaNotificationChannel v1alpha1,
+// with reportOnly set it only reports the drift of the converted object
+func (c *ConverterController) reconcileGrafanaNotificationChannel(ctx context.Context, l logr.Logger, notificationChannel *v1alpha1.GrafanaNotificationChannel, p placement, reportOnly bool) error {
+	l.Info(fmt.Sprintf("start converting GrafanaNotificationChannel %s to %s", v1alpha1.GroupVersion.String(), v1beta1.GroupVersion.String()))
+	if p.err != nil {
+		return p.err
+	}
+	contactPoint, err := c.convertGrafanaNotificationChannel(notificationChannel, p)
+	if err != nil {
+		// the source has to be fixed, retries will not help
+		return permanent(fmt.Errorf("cannot convert GrafanaNotificationChannel: %w", err))
+	}
 
-		if apiequality.Semantic.DeepEqual(notificationChannelOld.Spec, notificationChannel.Spec) {
-			l.Info("no diffs in GrafanaNotificationChannels")
-			return
+	var previous *v1beta1.GrafanaContactPoint
+	existingContactPoint, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaContactPoints(contactPoint.Namespace).Get(ctx, contactPoint.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("cannot get existing GrafanaContactPoint: %w", err)
 		}
-		l.Info(fmt.Sprintf("start converting GrafanaNotificationChannel %s to %s", v1alpha1.GroupVersion.String(), v1beta1.GroupVersion.String()))
-		contactPoint, err = c.convertGrafanaNotificationChannel(notificationChannel)
-		if err != nil {
-			l.Error(err, "cannot convert some GrafanaNotificationChannel at create")
-			return
+		if reportOnly {
+			l.Info(fmt.Sprintf("GrafanaContactPoint %v/%v has been deleted, the drift is reported and not restored", contactPoint.Namespace, contactPoint.Name))
+			return nil
 		}
 	} else {
-		contactPoint, ok = new.(*v1beta1.GrafanaContactPoint)
-		if !ok {
-			l.Error(fmt.Errorf("type assertion failed"), "cannot cast to v1beta1 GrafanaContactPoint")
-			return
+		previous = existingContactPoint
+		if !c.canUpdate(existingContactPoint) {
+			c.recordNotManaged(notificationChannel, existingContactPoint, "GrafanaContactPoint", existingContactPoint.Namespace, existingContactPoint.Name)
+			return permanent(fmt.Errorf("cannot update existing GrafanaContactPoint: %w", errNotManaged))
+		}
+		if !p.conf.Strategy.updatesExisting() {
+			l.Info(fmt.Sprintf("GrafanaContactPoint %v/%v already exists and is not updated with %q strategy", existingContactPoint.Namespace, existingContactPoint.Name, SyncStrategyCreateOnly))
+			return nil
+		}
+		if reportOnly {
+			if !apiequality.Semantic.DeepEqual(existingContactPoint.Spec, contactPoint.Spec) || c.metadataDrifted(existingContactPoint, contactPoint) {
+				l.Info(fmt.Sprintf("GrafanaContactPoint %v/%v has drifted from the converted state, the drift is reported and not restored", existingContactPoint.Namespace, existingContactPoint.Name))
+			}
+			return nil
+		}
+		if c.isUpToDate(v1alpha1.GrafanaNotificationChannelKind, existingContactPoint, contactPoint) {
+			l.Info(fmt.Sprintf("GrafanaContactPoint %v/%v is up to date with its source, it is not applied again", existingContactPoint.Namespace, existingContactPoint.Name))
+			return nil
+		}
+		adopted, err := backupPreviousSpec(&contactPoint.ObjectMeta, existingContactPoint, existingContactPoint.Spec)
+		if err != nil {
+			return permanent(fmt.Errorf("cannot adopt existing GrafanaContactPoint: %w", err))
+		}
+		if adopted {
+			l.Info(fmt.Sprintf("GrafanaContactPoint %v/%v is adopted by the converter, its previous spec is kept in %s annotation", existingContactPoint.Namespace, existingContactPoint.Name, previousSpecAnnotationKey))
+		}
+		ownershipPatch, err := fieldOwnershipPatch(existingContactPoint)
+		if err != nil {
+			return permanent(fmt.Errorf("cannot migrate field ownership of existing GrafanaContactPoint: %w", err))
+		}
+		if ownershipPatch != nil {
+			if _, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaContactPoints(existingContactPoint.Namespace).Patch(ctx, existingContactPoint.Name, types.JSONPatchType, ownershipPatch, metav1.PatchOptions{}); err != nil {
+				return fmt.Errorf("cannot migrate field ownership of existing GrafanaContactPoint: %w", err)
+			}
+			l.Info(fmt.Sprintf("GrafanaContactPoint %v/%v fields updated before server-side apply are now owned by %s", existingContactPoint.Namespace, existingContactPoint.Name, fieldManager))
+		}
+		if instanceSelectorChanged(existingContactPoint.Spec.InstanceSelector, contactPoint.Spec.InstanceSelector) {
+			client := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaContactPoints(existingContactPoint.Namespace)
+			err = recreateConverted(ctx, l, "GrafanaContactPoint", existingContactPoint,
+				func(ctx context.Context, options metav1.DeleteOptions) error {
+					return client.Delete(ctx, existingContactPoint.Name, options)
+				},
+				func(ctx context.Context) error {
+					_, err := client.Get(ctx, existingContactPoint.Name, metav1.GetOptions{})
+					return err
+				})
+			if err != nil {
+				return err
+			}
 		}
-		l = c.log.WithValues("kind", v1alpha1.GrafanaNotificationChannelKind, "name", contactPoint.Name, "ns", contactPoint.Namespace)
 	}
 
-	ctx := context.Background()
-	existingContactPoint, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaContactPoints(contactPoint.Namespace).Get(ctx, contactPoint.Name, metav1.GetOptions{})
+	applyConfiguration, err := grafanaContactPointApplyConfiguration(contactPoint)
 	if err != nil {
-		if errors.IsNotFound(err) {
-			var createdContactPoint *v1beta1.GrafanaContactPoint
-			if createdContactPoint, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaContactPoints(contactPoint.Namespace).Create(ctx, contactPoint, metav1.CreateOptions{}); err == nil {
-				l.Info(fmt.Sprintf("GrafanaContactPoint %v/%v uid:%v has been created",
-					createdContactPoint.GetNamespace(),
-					createdContactPoint.GetName(),
-					createdContactPoint.GetUID()))
-				return
-			}
+		return permanent(fmt.Errorf("cannot build apply configuration of GrafanaContactPoint: %w", err))
+	}
+	appliedContactPoint, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaContactPoints(contactPoint.Namespace).Apply(ctx, applyConfiguration, applyOptions)
+	if err != nil {
+		return fmt.Errorf("cannot apply GrafanaContactPoint: %w", err)
+	}
+	l.Info(fmt.Sprintf("GrafanaContactPoint %v/%v uid:%v has been applied",
+		appliedContactPoint.GetNamespace(),
+		appliedContactPoint.GetName(),
+		appliedContactPoint.GetUID()))
+	// applies which change nothing keep the resource version and are not recorded as conversions
+	if previous == nil || appliedContactPoint.ResourceVersion != previous.ResourceVersion {
+		c.recordConverted(notificationChannel, appliedContactPoint, "GrafanaContactPoint", appliedContactPoint.Namespace, appliedContactPoint.Name, droppedGrafanaNotificationChannelFields(notificationChannel))
+	}
+	return nil
+}
+
+// updateGrafanaNotificationChannelStatus writes the result of the conversion to the status of GrafanaNotificationChannel v1alpha1,
+// the conversion error is returned as is to be retried by the queue
+func (c *ConverterController) updateGrafanaNotificationChannelStatus(ctx context.Context, src *v1alpha1.GrafanaNotificationChannel, p placement, convertErr error) error {
+	status := conversionStatus(src.Status.Conversion, src.Generation, []string{p.targetName(src, src.Name)}, convertErr)
+	if apiequality.Semantic.DeepEqual(src.Status.Conversion, status) {
+		return convertErr
+	}
+	updated := src.DeepCopy()
+	updated.Status.Conversion = status
+	if _, err := c.v1alpha1clientset.IntegreatlyV1alpha1().GrafanaNotificationChannels(src.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return errors.Join(convertErr, fmt.Errorf("cannot update status of GrafanaNotificationChannel: %w", err))
+	}
+	return convertErr
+}
+
+// recordGrafanaNotificationChannelReferences annotates GrafanaNotificationChannel v1alpha1 with references to the GrafanaContactPoint v1beta1 objects converted from it
+func (c *ConverterController) recordGrafanaNotificationChannelReferences(ctx context.Context, l logr.Logger, src *v1alpha1.GrafanaNotificationChannel, p placement) error {
+	names := []string{p.targetName(src, src.Name)}
+	if err := c.deleteRetargetedObjects(ctx, l, src, "GrafanaContactPoint", p.conf.DeletionPolicy.NotificationChannel, p.namespace, names, c.deleteConvertedGrafanaContactPoint); err != nil {
+		return err
+	}
+	patch, err := convertedObjectsPatch(src, "GrafanaContactPoint", p.namespace, names)
+	if err != nil || patch == nil {
+		return err
+	}
+	if _, err = c.v1alpha1clientset.IntegreatlyV1alpha1().GrafanaNotificationChannels(src.Namespace).Patch(ctx, src.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("cannot record converted objects on GrafanaNotificationChannel: %w", err)
+	}
+	return nil
+}
+
+// deleteGrafanaNotificationChannel propagates deletion of GrafanaNotificationChannel v1alpha1 to GrafanaContactPoint v1beta1
+func (c *ConverterController) deleteGrafanaNotificationChannel(ctx context.Context, l logr.Logger, src *v1alpha1.GrafanaNotificationChannel, p placement) error {
+	namespace, name := p.namespace, p.targetName(src, src.Name)
+	if policy := c.convertedDeletionPolicy(p.conf.DeletionPolicy.NotificationChannel, src, namespace); policy != DeletionPolicyDelete {
+		l.Info(fmt.Sprintf("GrafanaNotificationChannel has been deleted or skipped, converted GrafanaContactPoint is left to %q deletion policy", policy))
+		if policy == DeletionPolicyOrphan {
+			c.recordOrphaned(v1alpha1.GrafanaNotificationChannelKind, src.Namespace+"/"+src.Name, "GrafanaContactPoint", namespace, []string{name})
+		}
+		return nil
+	}
+	return c.deleteConvertedGrafanaContactPoint(ctx, l, namespace, name)
+}
+
+// deleteConvertedGrafanaContactPoint deletes GrafanaContactPoint v1beta1 if it is managed by the converter
+func (c *ConverterController) deleteConvertedGrafanaContactPoint(ctx context.Context, l logr.Logger, namespace, name string) error {
+	existingContactPoint, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaContactPoints(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
 		}
-		l.Error(err, "cannot get existing GrafanaContactPoint")
-		return
+		return fmt.Errorf("cannot get existing GrafanaContactPoint: %w", err)
 	}
 	if !isConverterManaged(existingContactPoint) {
-		l.Error(fmt.Errorf("resource is not managed by the converter"), "cannot update existing GrafanaContactPoint")
-		return
+		l.Error(errNotManaged, "cannot delete existing GrafanaContactPoint")
+		return nil
 	}
 
-	if apiequality.Semantic.DeepEqual(existingContactPoint.Spec, contactPoint.Spec) {
-		l.Info("no updates in GrafanaContactPoint")
-		return
+	err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaContactPoints(namespace).Delete(ctx, name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &existingContactPoint.UID},
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("cannot delete GrafanaContactPoint: %w", err)
 	}
+	l.Info(fmt.Sprintf("GrafanaContactPoint %v/%v has been deleted", namespace, name))
+	return nil
+}
 
-	existingContactPoint.Spec = contactPoint.Spec
-	maps.Copy(existingContactPoint.Annotations, contactPoint.GetAnnotations())
-	maps.Copy(existingContactPoint.Labels, contactPoint.GetLabels())
-	existingContactPoint.OwnerReferences = contactPoint.GetOwnerReferences()
-
-	var updatedContactPoint *v1beta1.GrafanaContactPoint
-	updatedContactPoint, err = c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaContactPoints(existingContactPoint.Namespace).Update(ctx, existingContactPoint, metav1.UpdateOptions{})
+// sweepGrafanaNotificationChannels deletes converted GrafanaContactPoints v1beta1 whose source no longer exists
+func (c *ConverterController) sweepGrafanaNotificationChannels(ctx context.Context, namespace string) error {
+	contactPoints, err := c.v1beta1clientset.GrafanaIntegreatlyV1beta1().GrafanaContactPoints(namespace).List(ctx, managedByOperatorSelector)
 	if err != nil {
-		l.Error(err, "cannot update GrafanaContactPoint")
-		return
+		return err
 	}
-	l.Info(fmt.Sprintf("GrafanaContactPoint %v/%v uid:%v has been updated",
-		updatedContactPoint.GetNamespace(),
-		updatedContactPoint.GetName(),
-		updatedContactPoint.GetUID()))
+	var errs error
+	for _, contactPoint := range contactPoints.Items {
+		sourceName, ok := sourceNameOf(&contactPoint)
+		if !ok {
+			sourceName = contactPoint.Name
+		}
+		exists, err := c.convertedSourceExists(ctx, v1alpha1.GrafanaNotificationChannelKind, &contactPoint, sourceName)
+		if err != nil || exists {
+			continue
+		}
+		l := c.log.WithValues("kind", v1alpha1.GrafanaNotificationChannelKind, "name", sourceName, "ns", contactPoint.Namespace)
+		l.Info(fmt.Sprintf("source of GrafanaContactPoint %v/%v no longer exists", contactPoint.Namespace, contactPoint.Name))
+		errs = errors.Join(errs, c.deleteConvertedGrafanaContactPoint(ctx, l, contactPoint.Namespace, contactPoint.Name))
+	}
+	return errs
+}
+
+// grafanaContactPointApplyConfiguration declares fields of the converted GrafanaContactPoint owned by the converter
+func grafanaContactPointApplyConfiguration(dst *v1beta1.GrafanaContactPoint) (*v1beta1ac.GrafanaContactPointApplyConfiguration, error) {
+	applyConfiguration := v1beta1ac.GrafanaContactPoint(dst.Name, dst.Namespace)
+	applyConfiguration.ObjectMetaApplyConfiguration = objectMetaApplyConfiguration(dst.ObjectMeta)
+	applyConfiguration.Spec = &v1beta1ac.GrafanaContactPointSpecApplyConfiguration{}
+	return applyConfiguration, specApplyConfiguration(dst.Spec, applyConfiguration.Spec)
 }
 
 // convertGrafanaNotificationChannel creates GrafanaNotificationChannel v1beta1 from GrafanaNotificationChannel v1alpha1
-func (c *ConverterController) convertGrafanaNotificationChannel(src *v1alpha1.GrafanaNotificationChannel) (dst *v1beta1.GrafanaContactPoint, err error) {
+func (c *ConverterController) convertGrafanaNotificationChannel(src *v1alpha1.GrafanaNotificationChannel, p placement) (dst *v1beta1.GrafanaContactPoint, err error) {
 	c.log.Info(fmt.Sprintf("%s/%s conversion from %s to %s requested", src.Namespace, src.Name, v1alpha1.GroupVersion.String(), v1beta1.GroupVersion.String()))
+	conf := p.conf
 
 	var embeddedContactPoint models.EmbeddedContactPoint
 
@@ -143,17 +307,22 @@ func (c *ConverterController) convertGrafanaNotificationChannel(src *v1alpha1.Gr
 	}
 
 	dst = &v1beta1.GrafanaContactPoint{
-		ObjectMeta: convertedObjectMeta(src, src.Name),
+		ObjectMeta: c.convertedObjectMeta(src, p.namespace, p.targetName(src, src.Name), conf.Propagation),
 		Spec: v1beta1.GrafanaContactPointSpec{
 			Name:                      embeddedContactPoint.Name,
 			Type:                      *embeddedContactPoint.Type,
 			DisableResolveMessage:     embeddedContactPoint.DisableResolveMessage,
 			Settings:                  jsonPtr(embeddedContactPoint.Settings),
-			AllowCrossNamespaceImport: ptr.To(true),
-			ResyncPeriod:              metav1.Duration{Duration: v1beta1.DefaultResyncPeriodDuration},
-			InstanceSelector:          c.ConverterConf.InstanceSelector,
+			AllowCrossNamespaceImport: allowCrossNamespaceImport(conf, conf.Defaults.NotificationChannel),
+			ResyncPeriod:              conf.Defaults.NotificationChannel.resyncPeriod(),
+			InstanceSelector:          conf.InstanceSelector,
 		},
 	}
+	conf.Defaults.NotificationChannel.applyMetadata(&dst.ObjectMeta)
+	if conf.DeletionPolicy.NotificationChannel == DeletionPolicyOwnerReference {
+		c.setSourceOwnerReference(&dst.ObjectMeta, src, v1alpha1.GrafanaNotificationChannelKind)
+	}
+	stampProvenance(&dst.ObjectMeta, src, v1alpha1.GrafanaNotificationChannelKind, contentHash(src.Spec), conf)
 
 	c.log.Info(fmt.Sprintf("%s/%s has been successfully converted from %s to %s", src.Namespace, src.Name, v1alpha1.GroupVersion.String(), v1beta1.GroupVersion.String()))
 	return dst, err
@@ -0,0 +1,230 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	converterv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/converter/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	kjson "sigs.k8s.io/json"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// namespaceConfigAnnotationKey on a namespace overrides the converter configuration in the namespace
+	namespaceConfigAnnotationKey = converterAnnotationPrefix + "config"
+	// NamespaceConfigMapName is the name of the ConfigMap which overrides the converter configuration in its namespace
+	NamespaceConfigMapName = "grafana-converter-config"
+	// namespaceConfigMapKey is the key of the override in the ConfigMap
+	namespaceConfigMapKey = "parameters.yaml"
+)
+
+// NamespaceOverride overrides the converter configuration in one namespace, unset fields keep global values
+type NamespaceOverride struct {
+	// Enable opts the namespace in or out of the conversion
+	Enable              *bool                 `json:"enable,omitempty" yaml:"enable,omitempty"`
+	InstanceSelector    *metav1.LabelSelector `json:"instanceSelector,omitempty" yaml:"instanceSelector,omitempty"`
+	FolderTitle         *string               `json:"folderTitle,omitempty" yaml:"folderTitle,omitempty"`
+	Dashboard           *bool                 `json:"dashboard,omitempty" yaml:"dashboard,omitempty"`
+	Datasource          *bool                 `json:"datasource,omitempty" yaml:"datasource,omitempty"`
+	Folder              *bool                 `json:"folder,omitempty" yaml:"folder,omitempty"`
+	NotificationChannel *bool                 `json:"notification,omitempty" yaml:"notification,omitempty"`
+}
+
+// ParseNamespaceOverride decodes and validates the override of a namespace in YAML or JSON
+func ParseNamespaceOverride(data []byte) (*NamespaceOverride, error) {
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse override: %w", err)
+	}
+	override := &NamespaceOverride{}
+	strictErrs, err := kjson.UnmarshalStrict(jsonData, override, kjson.DisallowDuplicateFields, kjson.DisallowUnknownFields)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode override: %w", err)
+	}
+	if override.InstanceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(override.InstanceSelector); err != nil {
+			strictErrs = append(strictErrs, fmt.Errorf("instanceSelector: %w", err))
+		}
+	}
+	if err = errors.Join(strictErrs...); err != nil {
+		return nil, err
+	}
+	return override, nil
+}
+
+// apply merges the override over the configuration
+func (o *NamespaceOverride) apply(conf ConverterConfig) ConverterConfig {
+	// an enabled namespace is converted even if namespaces have to opt in, a disabled one is never converted
+	if o.Enable != nil {
+		conf.NamespaceOptIn = !*o.Enable
+	}
+	if o.InstanceSelector != nil {
+		conf.InstanceSelector = o.InstanceSelector
+	}
+	if o.FolderTitle != nil {
+		conf.FolderTitle = *o.FolderTitle
+	}
+	for _, kind := range []struct {
+		enabled  *bool
+		override *bool
+	}{
+		{&conf.Dashboard, o.Dashboard},
+		{&conf.Datasource, o.Datasource},
+		{&conf.Folder, o.Folder},
+		{&conf.NotificationChannel, o.NotificationChannel},
+	} {
+		// kinds disabled globally have no informers, so they can only be disabled in a namespace
+		if kind.override != nil {
+			*kind.enabled = *kind.enabled && *kind.override
+		}
+	}
+	return conf
+}
+
+// namespaceOverrideSource is an override of a namespace and the object it is read from
+type namespaceOverrideSource struct {
+	source string
+	data   string
+}
+
+// namespaceOverrides returns overrides of the namespace in the order they are merged,
+// the namespace annotation takes precedence over the ConfigMap in the namespace
+func (c *ConverterController) namespaceOverrides(namespace string) []namespaceOverrideSource {
+	var overrides []namespaceOverrideSource
+	c.mu.RLock()
+	informers, ok := c.namespaceInformers[namespace]
+	if !ok {
+		informers = c.namespaceInformers[metav1.NamespaceAll]
+	}
+	c.mu.RUnlock()
+
+	if informers != nil && informers.overrides != nil {
+		configMap, err := informers.overrides.Core().V1().ConfigMaps().Lister().ConfigMaps(namespace).Get(NamespaceConfigMapName)
+		if err == nil {
+			overrides = append(overrides, namespaceOverrideSource{"ConfigMap " + NamespaceConfigMapName, configMap.Data[namespaceConfigMapKey]})
+		} else if !apierrors.IsNotFound(err) {
+			c.log.Error(err, "cannot get converter override ConfigMap", "ns", namespace)
+		}
+	}
+	if c.namespaceLister != nil {
+		if ns, err := c.namespaceLister.Get(namespace); err == nil {
+			if data, ok := ns.Annotations[namespaceConfigAnnotationKey]; ok {
+				overrides = append(overrides, namespaceOverrideSource{"annotation " + namespaceConfigAnnotationKey, data})
+			}
+		}
+	}
+	return overrides
+}
+
+// namespaceConfig returns the configuration merged with overrides of the namespace
+func (c *ConverterController) namespaceConfig(namespace string) (ConverterConfig, []string, error) {
+	conf := c.config()
+	var sources []string
+	for _, override := range c.namespaceOverrides(namespace) {
+		sources = append(sources, override.source)
+		parsed, err := ParseNamespaceOverride([]byte(override.data))
+		if err != nil {
+			return conf, sources, fmt.Errorf("invalid converter override in %s of namespace %s: %w", override.source, namespace, err)
+		}
+		conf = parsed.apply(conf)
+	}
+	return conf, sources, nil
+}
+
+// configFor returns the effective configuration of v1alpha1 objects in the namespace. Errors of invalid overrides
+// are dropped here, because inWatchedNamespaces skips keys of such namespaces before they are synced.
+func (c *ConverterController) configFor(namespace string) ConverterConfig {
+	conf, _, _ := c.namespaceConfig(namespace)
+	return conf
+}
+
+// convertsKind reports whether v1alpha1 objects of the kind are converted with the configuration
+func (c *ConverterController) convertsKind(conf ConverterConfig, kind string) bool {
+	if !conf.Enable || conf.NamespaceOptIn {
+		return false
+	}
+	for _, converted := range c.convertedKinds(conf) {
+		if converted.kind == kind {
+			return converted.enabled
+		}
+	}
+	return false
+}
+
+// namespaceOverrideChanged converts v1alpha1 objects of the namespace again after its overrides changed
+func (c *ConverterController) namespaceOverrideChanged(namespace string) {
+	c.log.Info("converter override of namespace changed, converting its objects again", "ns", namespace)
+	c.mu.RLock()
+	queues, informerFactories := c.queues, c.v1alpha1InformerFactory
+	c.mu.RUnlock()
+	for _, kind := range c.convertedKinds(ConverterConfig{}) {
+		queue, ok := queues[kind.kind]
+		if !ok {
+			continue
+		}
+		for _, informerFactory := range informerFactories {
+			objects, err := kind.informer(informerFactory).GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+			if err != nil {
+				continue
+			}
+			for _, obj := range objects {
+				queue.enqueue(obj)
+			}
+		}
+	}
+	c.refreshConfigurationStatus(c.ctx)
+}
+
+// namespaceStatuses returns effective configurations of namespaces with overrides
+func (c *ConverterController) namespaceStatuses() []converterv1alpha1.NamespaceConfigurationStatus {
+	var namespaces []string
+	c.mu.RLock()
+	for _, informers := range c.namespaceInformers {
+		if informers.overrides == nil {
+			continue
+		}
+		configMaps, err := informers.overrides.Core().V1().ConfigMaps().Lister().List(labels.Everything())
+		if err != nil {
+			continue
+		}
+		for _, configMap := range configMaps {
+			if configMap.Name == NamespaceConfigMapName {
+				namespaces = append(namespaces, configMap.Namespace)
+			}
+		}
+	}
+	if c.namespaceLister != nil {
+		if all, err := c.namespaceLister.List(labels.Everything()); err == nil {
+			for _, ns := range all {
+				if _, ok := ns.Annotations[namespaceConfigAnnotationKey]; ok {
+					namespaces = append(namespaces, ns.Name)
+				}
+			}
+		}
+	}
+	c.mu.RUnlock()
+	slices.Sort(namespaces)
+	namespaces = slices.Compact(namespaces)
+
+	var statuses []converterv1alpha1.NamespaceConfigurationStatus
+	for _, namespace := range namespaces {
+		conf, sources, err := c.namespaceConfig(namespace)
+		status := converterv1alpha1.NamespaceConfigurationStatus{Namespace: namespace, Overrides: sources}
+		if err != nil {
+			status.Error = err.Error()
+		} else {
+			if !conf.NamespaceOptIn {
+				status.ActiveConverters = c.activeConverters(conf)
+			}
+			status.InstanceSelector = conf.InstanceSelector
+			status.FolderTitle = conf.FolderTitle
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
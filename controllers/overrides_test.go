@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func TestParseNamespaceOverride(t *testing.T) {
+	override, err := ParseNamespaceOverride([]byte("enable: true\ndashboard: false\nfolderTitle: Team A\ninstanceSelector:\n  matchLabels:\n    app: grafana-a\n"))
+	require.NoError(t, err)
+	conf := override.apply(ConverterConfig{
+		Enable:                  true,
+		NamespaceOptIn:          true,
+		EnabledGrafanaConverter: EnabledGrafanaConverter{Dashboard: true, Folder: true},
+	})
+	assert.False(t, conf.NamespaceOptIn)
+	assert.Equal(t, EnabledGrafanaConverter{Folder: true}, conf.EnabledGrafanaConverter)
+	assert.Equal(t, "Team A", conf.FolderTitle)
+	assert.Equal(t, map[string]string{"app": "grafana-a"}, conf.InstanceSelector.MatchLabels)
+
+	override, err = ParseNamespaceOverride([]byte("datasource: true\n"))
+	require.NoError(t, err)
+	assert.False(t, override.apply(ConverterConfig{}).Datasource, "kinds disabled globally can not be enabled in a namespace")
+
+	_, err = ParseNamespaceOverride([]byte("strategy: mirror\n"))
+	assert.ErrorContains(t, err, `unknown field "strategy"`)
+}
+
+func TestNamespaceOverridesMergeOverGlobalConfig(t *testing.T) {
+	kubeClient := kubefake.NewClientset(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "product-a", Annotations: map[string]string{
+			namespaceConfigAnnotationKey: "instanceSelector:\n  matchLabels:\n    app: grafana-a\n",
+		}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "product-b"}},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: NamespaceConfigMapName, Namespace: "product-a"},
+			Data:       map[string]string{namespaceConfigMapKey: "instanceSelector:\n  matchLabels:\n    app: ignored\n"},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: NamespaceConfigMapName, Namespace: "product-b"},
+			Data:       map[string]string{namespaceConfigMapKey: "enable: false\n"},
+		},
+	)
+	path := filepath.Join(t.TempDir(), "parameters.yaml")
+	writeConfig(t, path, "enable: true\nfolder: true\ninstanceSelector:\n  matchLabels:\n    app: grafana\n")
+	client := newFakeV1beta1Clientset()
+	controller, err := NewGrafanaConverterController(context.Background(), path, v1alpha1fake.NewSimpleClientset(
+		&v1alpha1.GrafanaFolder{ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"}},
+		&v1alpha1.GrafanaFolder{ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-b"}},
+	), client, nil, kubeClient, nil, 0, logr.Discard())
+	require.NoError(t, err)
+	instance := func(namespace string) string {
+		folder, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders(namespace).Get(context.Background(), "sample-folder", metav1.GetOptions{})
+		if err != nil {
+			return ""
+		}
+		return folder.Spec.InstanceSelector.MatchLabels["app"]
+	}
+
+	startController(t, controller)
+
+	require.Eventually(t, func() bool { return instance("product-a") == "grafana-a" }, 5*time.Second, 10*time.Millisecond,
+		"the namespace annotation takes precedence over the ConfigMap")
+	assert.Empty(t, instance("product-b"), "namespaces which opt out are not converted")
+
+	statuses := controller.namespaceStatuses()
+	require.Len(t, statuses, 2)
+	assert.Equal(t, "product-a", statuses[0].Namespace)
+	assert.Equal(t, []string{"ConfigMap " + NamespaceConfigMapName, "annotation " + namespaceConfigAnnotationKey}, statuses[0].Overrides)
+	assert.Equal(t, []string{v1alpha1.GrafanaFolderKind}, statuses[0].ActiveConverters)
+	assert.Equal(t, "product-b", statuses[1].Namespace)
+	assert.Empty(t, statuses[1].ActiveConverters)
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: NamespaceConfigMapName, Namespace: "product-b"},
+		Data:       map[string]string{namespaceConfigMapKey: "enable: true\ninstanceSelector:\n  matchLabels:\n    app: grafana-b\n"},
+	}
+	_, err = kubeClient.CoreV1().ConfigMaps("product-b").Update(context.Background(), configMap, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.Eventually(t, func() bool { return instance("product-b") == "grafana-b" }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestOverrideConfigMapsAreListedByName(t *testing.T) {
+	kubeClient := kubefake.NewClientset()
+	controller := &ConverterController{ctx: context.Background(), log: logr.Discard(), kubeclientset: kubeClient}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	factory, err := controller.watchOverrideConfigMaps(metav1.NamespaceAll)
+	require.NoError(t, err)
+	factory.Start(ctx.Done())
+	factory.WaitForCacheSync(ctx.Done())
+
+	// the chart grants access only to ConfigMaps of this name
+	var listed int
+	for _, action := range kubeClient.Actions() {
+		if action.GetResource().Resource != "configmaps" {
+			continue
+		}
+		list, ok := action.(clienttesting.ListAction)
+		if !ok {
+			continue
+		}
+		listed++
+		assert.Equal(t, "metadata.name="+NamespaceConfigMapName, list.GetListRestrictions().Fields.String())
+	}
+	assert.Positive(t, listed)
+}
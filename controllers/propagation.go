@@ -0,0 +1,171 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// KeyMatcher matches keys of labels or annotations, exactly one of its fields has to be set
+type KeyMatcher struct {
+	// Key matches the key itself
+	Key string `json:"key,omitempty" yaml:"key,omitempty"`
+	// Prefix matches keys which start with it
+	Prefix string `json:"prefix,omitempty" yaml:"prefix,omitempty"`
+	// Regex matches keys which match the whole regular expression
+	Regex string `json:"regex,omitempty" yaml:"regex,omitempty"`
+}
+
+func (m KeyMatcher) validate() error {
+	set := 0
+	for _, field := range []string{m.Key, m.Prefix, m.Regex} {
+		if field != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return errors.New("exactly one of key, prefix and regex must be set")
+	}
+	if m.Regex != "" {
+		if _, err := compileKeyRegex(m.Regex); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m KeyMatcher) matches(key string) bool {
+	switch {
+	case m.Key != "":
+		return key == m.Key
+	case m.Prefix != "":
+		return strings.HasPrefix(key, m.Prefix)
+	case m.Regex != "":
+		// the configuration is validated, so the expression compiles
+		re, err := compileKeyRegex(m.Regex)
+		return err == nil && re.MatchString(key)
+	}
+	return false
+}
+
+// keyRegexes caches compiled regular expressions of key matchers by expression
+var keyRegexes sync.Map
+
+// compileKeyRegex compiles the regular expression of a key matcher, it matches whole keys
+func compileKeyRegex(expression string) (*regexp.Regexp, error) {
+	if re, ok := keyRegexes.Load(expression); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(`^(?:` + expression + `)$`)
+	if err != nil {
+		return nil, err
+	}
+	keyRegexes.Store(expression, re)
+	return re, nil
+}
+
+func matchesAny(matchers []KeyMatcher, key string) bool {
+	return slices.ContainsFunc(matchers, func(m KeyMatcher) bool { return m.matches(key) })
+}
+
+// PropagationRules define which labels or annotations of v1alpha1 objects are copied to converted objects
+type PropagationRules struct {
+	// Allow lists keys which are copied, all keys are copied if it is empty
+	Allow []KeyMatcher `json:"allow,omitempty" yaml:"allow,omitempty"`
+	// Deny lists keys which are not copied, it takes precedence over Allow
+	Deny []KeyMatcher `json:"deny,omitempty" yaml:"deny,omitempty"`
+	// Add sets keys of all converted objects, they take precedence over keys of sources
+	Add map[string]string `json:"add,omitempty" yaml:"add,omitempty"`
+}
+
+func (r PropagationRules) validate(field string) []error {
+	var errs []error
+	for i, matcher := range r.Allow {
+		if err := matcher.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("propagation.%s.allow[%d]: %w", field, i, err))
+		}
+	}
+	for i, matcher := range r.Deny {
+		if err := matcher.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("propagation.%s.deny[%d]: %w", field, i, err))
+		}
+	}
+	return errs
+}
+
+// propagates reports whether the key of a source is copied, builtin lists deny keys besides configured ones
+func (r PropagationRules) propagates(key string, builtin []KeyMatcher) bool {
+	if len(r.Allow) != 0 && !matchesAny(r.Allow, key) {
+		return false
+	}
+	return !matchesAny(r.Deny, key) && !matchesAny(builtin, key)
+}
+
+// Propagation defines how labels and annotations of v1alpha1 objects are copied to converted objects
+type Propagation struct {
+	Labels      PropagationRules `json:"labels,omitempty" yaml:"labels,omitempty"`
+	Annotations PropagationRules `json:"annotations,omitempty" yaml:"annotations,omitempty"`
+	// KeepToolTracking copies labels and annotations deployment tools track their objects with,
+	// by default they are not copied, so the tools do not take converted objects for their own ones
+	KeepToolTracking bool `json:"keepToolTracking,omitempty" yaml:"keepToolTracking,omitempty"`
+}
+
+// validate returns all problems of the propagation rules
+func (p Propagation) validate() error {
+	errs := append(p.Labels.validate("labels"), p.Annotations.validate("annotations")...)
+	errs = append(errs, validateLabels("propagation.labels.add", p.Labels.Add)...)
+	errs = append(errs, validateAnnotations("propagation.annotations.add", p.Annotations.Add)...)
+	return errors.Join(errs...)
+}
+
+var (
+	// toolTrackingLabels are labels Helm, Argo CD and Flux put on objects they deploy
+	toolTrackingLabels = []KeyMatcher{
+		{Key: "app.kubernetes.io/managed-by"},
+		{Key: "app.kubernetes.io/instance"},
+		{Key: "helm.sh/chart"},
+		{Prefix: "argocd.argoproj.io/"},
+		{Prefix: "kustomize.toolkit.fluxcd.io/"},
+		{Prefix: "helm.toolkit.fluxcd.io/"},
+	}
+	// toolTrackingAnnotations are annotations kubectl, Helm, Argo CD and Flux put on objects they deploy
+	toolTrackingAnnotations = []KeyMatcher{
+		{Key: "kubectl.kubernetes.io/last-applied-configuration"},
+		{Prefix: "meta.helm.sh/"},
+		{Prefix: "argocd.argoproj.io/"},
+		{Prefix: "kustomize.toolkit.fluxcd.io/"},
+		{Prefix: "helm.toolkit.fluxcd.io/"},
+	}
+)
+
+// labels returns labels of the converted object: propagated labels of the source and added ones
+func (p Propagation) labels(source map[string]string) map[string]string {
+	return p.Labels.apply(source, p.builtin(toolTrackingLabels))
+}
+
+// annotations returns annotations of the converted object: propagated annotations of the source and added ones
+func (p Propagation) annotations(source map[string]string) map[string]string {
+	return p.Annotations.apply(source, p.builtin(toolTrackingAnnotations))
+}
+
+func (p Propagation) builtin(toolTracking []KeyMatcher) []KeyMatcher {
+	if p.KeepToolTracking {
+		return nil
+	}
+	return toolTracking
+}
+
+func (r PropagationRules) apply(source map[string]string, builtin []KeyMatcher) map[string]string {
+	result := make(map[string]string, len(source)+len(r.Add)+1)
+	for key, value := range source {
+		if r.propagates(key, builtin) {
+			result[key] = value
+		}
+	}
+	maps.Copy(result, r.Add)
+	return result
+}
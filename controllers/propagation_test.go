@@ -0,0 +1,85 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPropagationValidation(t *testing.T) {
+	_, err := ParseConfig([]byte(`
+propagation:
+  labels:
+    allow:
+    - key: team
+      prefix: team.example.com/
+    deny:
+    - regex: "team("
+    add:
+      app.kubernetes.io/managed-by-operator: someone
+  annotations:
+    deny:
+    - {}
+`))
+	assert.ErrorContains(t, err, "propagation.labels.allow[0]: exactly one of key, prefix and regex must be set")
+	assert.ErrorContains(t, err, "propagation.labels.deny[0]: error parsing regexp")
+	assert.ErrorContains(t, err, `propagation.labels.add: "app.kubernetes.io/managed-by-operator" is reserved for the converter`)
+	assert.ErrorContains(t, err, "propagation.annotations.deny[0]: exactly one of key, prefix and regex must be set")
+}
+
+func TestConvertedObjectMetaPropagation(t *testing.T) {
+	source := &v1alpha1.GrafanaDashboard{ObjectMeta: metav1.ObjectMeta{
+		Name:      "sample-dashboard",
+		Namespace: "product-a",
+		Labels: map[string]string{
+			"app":                          "product-a",
+			"team":                         "a",
+			"internal.example.com/cost":    "1",
+			"app.kubernetes.io/managed-by": "Helm",
+			"app.kubernetes.io/instance":   "product-a",
+		},
+		Annotations: map[string]string{
+			"description": "Sample dashboard",
+			"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			"meta.helm.sh/release-name":                        "product-a",
+			"argocd.argoproj.io/tracking-id":                   "product-a:integreatly.org/GrafanaDashboard:product-a/sample-dashboard",
+			"grafana-converter.qubership.org/paused":           "false",
+		},
+	}}
+
+	c := &ConverterController{}
+	meta := c.convertedObjectMeta(source, "product-a", "sample-dashboard", Propagation{})
+	assert.Equal(t, map[string]string{
+		"app":                                   "product-a",
+		"team":                                  "a",
+		"internal.example.com/cost":             "1",
+		"app.kubernetes.io/managed-by-operator": "grafana-operator-converter",
+	}, meta.Labels, "labels of deployment tools are not copied")
+	assert.Equal(t, map[string]string{
+		"description": "Sample dashboard",
+		"grafana-converter.qubership.org/source-name": "sample-dashboard",
+	}, meta.Annotations)
+
+	meta = c.convertedObjectMeta(source, "product-a", "sample-dashboard", Propagation{
+		Labels: PropagationRules{
+			Allow: []KeyMatcher{{Regex: "app|team|.*/.*"}},
+			Deny:  []KeyMatcher{{Prefix: "internal.example.com/"}, {Key: "app"}},
+			Add:   map[string]string{"team": "observability", "migrated": "true"},
+		},
+		Annotations:      PropagationRules{Allow: []KeyMatcher{{Prefix: "meta.helm.sh/"}}},
+		KeepToolTracking: true,
+	})
+	assert.Equal(t, map[string]string{
+		"team":                                  "observability",
+		"migrated":                              "true",
+		"app.kubernetes.io/managed-by":          "Helm",
+		"app.kubernetes.io/instance":            "product-a",
+		"app.kubernetes.io/managed-by-operator": "grafana-operator-converter",
+	}, meta.Labels, "deny takes precedence over allow and added labels over labels of sources")
+	assert.Equal(t, map[string]string{
+		"meta.helm.sh/release-name":                   "product-a",
+		"grafana-converter.qubership.org/source-name": "sample-dashboard",
+	}, meta.Annotations)
+}
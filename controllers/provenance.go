@@ -0,0 +1,126 @@
+package controllers
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// sourceAPIVersionAnnotationKey, sourceKindAnnotationKey, sourceNamespaceAnnotationKey, sourceUIDAnnotationKey
+	// and sourceGenerationAnnotationKey identify the v1alpha1 object a converted object was produced from
+	sourceAPIVersionAnnotationKey = converterAnnotationPrefix + "source-api-version"
+	sourceKindAnnotationKey       = converterAnnotationPrefix + "source-kind"
+	sourceNamespaceAnnotationKey  = converterAnnotationPrefix + "source-namespace"
+	sourceUIDAnnotationKey        = converterAnnotationPrefix + "source-uid"
+	sourceGenerationAnnotationKey = converterAnnotationPrefix + "source-generation"
+	// sourceHashAnnotationKey keeps the hash of the v1alpha1 object content a converted object was produced from
+	sourceHashAnnotationKey = converterAnnotationPrefix + "source-hash"
+	// conversionHashAnnotationKey keeps the hash of the converter configuration and the propagated metadata
+	// a converted object was produced with
+	conversionHashAnnotationKey = converterAnnotationPrefix + "conversion-hash"
+	// convertedObjectsAnnotationKey keeps references to the converted objects on the v1alpha1 source
+	convertedObjectsAnnotationKey = converterAnnotationPrefix + "converted-objects"
+)
+
+// convertedObjectReference refers to a v1beta1 object from its v1alpha1 source
+type convertedObjectReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+}
+
+// contentHash returns the hash of the JSON representation of v1alpha1 content
+// for kinds which have no Hash method of their own
+func contentHash(content interface{}) string {
+	hash := sha256.New()
+	json.NewEncoder(hash).Encode(content) // nolint
+	return fmt.Sprintf("%x", hash.Sum(nil))
+}
+
+// stampProvenance annotates the converted object with the identity and the content hash of its v1alpha1 source
+// and with the hash of everything else the converted object depends on, so unchanged sources are not applied again
+func stampProvenance(meta *metav1.ObjectMeta, source metav1.Object, kind, hash string, conf ConverterConfig) {
+	// the conversion hash is computed before provenance annotations are added, they are compared on their own
+	content := []interface{}{conf, meta.Labels, meta.Annotations, meta.OwnerReferences}
+	if nonce, ok := source.GetAnnotations()[reconcileNonceAnnotationKey]; ok {
+		// a new nonce forces the conversion of an unchanged source
+		content = append(content, nonce)
+	}
+	meta.Annotations[conversionHashAnnotationKey] = contentHash(content)
+	meta.Annotations[sourceAPIVersionAnnotationKey] = v1alpha1.GroupVersion.String()
+	meta.Annotations[sourceKindAnnotationKey] = kind
+	meta.Annotations[sourceNamespaceAnnotationKey] = source.GetNamespace()
+	meta.Annotations[sourceNameAnnotationKey] = source.GetName()
+	meta.Annotations[sourceUIDAnnotationKey] = string(source.GetUID())
+	meta.Annotations[sourceGenerationAnnotationKey] = strconv.FormatInt(source.GetGeneration(), 10)
+	meta.Annotations[sourceHashAnnotationKey] = hash
+}
+
+// isUpToDate reports whether the existing converted object was produced from the same source content, generation
+// and configuration as the desired one, so applying it again changes nothing. Objects enqueued because their
+// converted object drifted always apply the desired state, the mirror strategy applies it to objects edited by others.
+func (c *ConverterController) isUpToDate(kind string, existing, desired metav1.Object) bool {
+	if !isConverterManaged(existing) || c.config().Strategy.enforcesState() && editedByOthers(existing) {
+		return false
+	}
+	desiredAnnotations := desired.GetAnnotations()
+	if c.queue(kind).isDriftOnly(sourceNamespaceOf(desired) + "/" + desiredAnnotations[sourceNameAnnotationKey]) {
+		return false
+	}
+	for _, key := range []string{sourceUIDAnnotationKey, sourceGenerationAnnotationKey, sourceHashAnnotationKey, conversionHashAnnotationKey} {
+		if value, ok := existing.GetAnnotations()[key]; !ok || value != desiredAnnotations[key] {
+			return false
+		}
+	}
+	return true
+}
+
+// cachedUpToDate reports whether the converted object in informer caches is up to date with the desired metadata,
+// so its source is neither converted nor compared with the object read from the API server
+func (c *ConverterController) cachedUpToDate(kind string, cached metav1.Object, desired *metav1.ObjectMeta, p placement, reportOnly bool) bool {
+	return !reportOnly && p.conf.Strategy.updatesExisting() && c.isUpToDate(kind, cached, desired)
+}
+
+// editedByOthers reports whether managers other than the converter own fields of the converted object,
+// for example after it was edited while the converter was not running. Status updates are not edits.
+func editedByOthers(object metav1.Object) bool {
+	for _, entry := range object.GetManagedFields() {
+		if entry.Manager != fieldManager && entry.Subresource == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// convertedObjectsPatch returns the merge patch which records references to the converted objects of the kind
+// in the namespace on their v1alpha1 source, nil if the source already has them
+func convertedObjectsPatch(source metav1.Object, kind, namespace string, names []string) ([]byte, error) {
+	references := make([]convertedObjectReference, 0, len(names))
+	for _, name := range names {
+		references = append(references, convertedObjectReference{
+			APIVersion: v1beta1.GroupVersion.String(),
+			Kind:       kind,
+			Namespace:  namespace,
+			Name:       name,
+		})
+	}
+	value, err := json.Marshal(references)
+	if err != nil {
+		return nil, err
+	}
+	if source.GetAnnotations()[convertedObjectsAnnotationKey] == string(value) {
+		return nil, nil
+	}
+	return json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{convertedObjectsAnnotationKey: string(value)},
+		},
+	})
+}
@@ -0,0 +1,143 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	v1alpha1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions"
+	v1beta1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/informers/externalversions"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func TestConvertGrafanaDashboardStampsProvenance(t *testing.T) {
+	source := &v1alpha1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "product-a", UID: "source-uid", Generation: 4},
+		Spec:       v1alpha1.GrafanaDashboardSpec{Json: `{"title":"sample"}`},
+	}
+	controller := &ConverterController{log: logr.Discard()}
+
+	converted := controller.convertGrafanaDashboard(source, controller.place(v1alpha1.GrafanaDashboardKind, source))
+
+	assert.Equal(t, "integreatly.org/v1alpha1", converted.Annotations[sourceAPIVersionAnnotationKey])
+	assert.Equal(t, v1alpha1.GrafanaDashboardKind, converted.Annotations[sourceKindAnnotationKey])
+	assert.Equal(t, "product-a", converted.Annotations[sourceNamespaceAnnotationKey])
+	assert.Equal(t, "sample", converted.Annotations[sourceNameAnnotationKey])
+	assert.Equal(t, "source-uid", converted.Annotations[sourceUIDAnnotationKey])
+	assert.Equal(t, "4", converted.Annotations[sourceGenerationAnnotationKey])
+	assert.Equal(t, source.Hash(), converted.Annotations[sourceHashAnnotationKey])
+	assert.NotEmpty(t, converted.Annotations[conversionHashAnnotationKey])
+}
+
+func TestReconcileGrafanaDashboardSkipsUnchangedSource(t *testing.T) {
+	client := newFakeV1beta1Clientset()
+	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
+	source := &v1alpha1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "product-a", UID: "source-uid", Generation: 1},
+		Spec:       v1alpha1.GrafanaDashboardSpec{Json: `{"title":"sample"}`},
+	}
+	applies := func() int {
+		count := 0
+		for _, action := range client.Actions() {
+			if _, ok := action.(k8stesting.PatchAction); ok {
+				count++
+			}
+		}
+		return count
+	}
+	require.NoError(t, controller.reconcileGrafanaDashboard(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDashboardKind, source), false))
+	require.Equal(t, 1, applies())
+
+	require.NoError(t, controller.reconcileGrafanaDashboard(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDashboardKind, source), false))
+	assert.Equal(t, 1, applies(), "unchanged source must not be applied again")
+
+	source.Labels = map[string]string{"team": "a"}
+	require.NoError(t, controller.reconcileGrafanaDashboard(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDashboardKind, source), false))
+	assert.Equal(t, 2, applies(), "changed labels must be applied")
+
+	conf := ConverterConfig{InstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "grafana"}}}
+	controller.setConfig(conf)
+	require.NoError(t, controller.reconcileGrafanaDashboard(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDashboardKind, source), false))
+	assert.Equal(t, 3, applies(), "changed configuration must be applied")
+
+	source.Spec.Json = `{"title":"renamed"}`
+	source.Generation++
+	require.NoError(t, controller.reconcileGrafanaDashboard(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDashboardKind, source), false))
+	assert.Equal(t, 4, applies(), "changed content must be applied")
+
+	conf.Strategy = SyncStrategyMirror
+	controller.setConfig(conf)
+	require.NoError(t, controller.reconcileGrafanaDashboard(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDashboardKind, source), false))
+	require.Equal(t, 5, applies())
+	require.NoError(t, controller.reconcileGrafanaDashboard(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDashboardKind, source), false))
+	assert.Equal(t, 5, applies(), "the mirror strategy does not apply unchanged sources again")
+
+	edited, err := client.GrafanaIntegreatlyV1beta1().GrafanaDashboards("product-a").Get(context.Background(), "sample", metav1.GetOptions{})
+	require.NoError(t, err)
+	edited.Spec.Json = `{"title":"edited"}`
+	_, err = client.GrafanaIntegreatlyV1beta1().GrafanaDashboards("product-a").Update(context.Background(), edited, metav1.UpdateOptions{FieldManager: "kubectl-edit"})
+	require.NoError(t, err)
+	require.NoError(t, controller.reconcileGrafanaDashboard(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDashboardKind, source), false))
+	assert.Equal(t, 6, applies(), "the mirror strategy applies objects edited by others")
+}
+
+func TestReconcileGrafanaDashboardComparesCachedObjectBeforeConverting(t *testing.T) {
+	client := newFakeV1beta1Clientset()
+	informerFactory := v1beta1informers.NewSharedInformerFactory(client, 0)
+	controller := &ConverterController{
+		log:                    logr.Discard(),
+		v1beta1clientset:       client,
+		v1beta1InformerFactory: []v1beta1informers.SharedInformerFactory{informerFactory},
+	}
+	source := &v1alpha1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "product-a", UID: "source-uid", Generation: 1},
+		Spec:       v1alpha1.GrafanaDashboardSpec{GzipJson: []byte("large gzipped dashboard")},
+	}
+	cached := controller.convertGrafanaDashboard(source, controller.place(v1alpha1.GrafanaDashboardKind, source))
+	require.NoError(t, informerFactory.Observability().V1beta1().GrafanaDashboards().Informer().GetStore().Add(cached))
+
+	require.NoError(t, controller.reconcileGrafanaDashboard(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDashboardKind, source), false))
+	assert.Empty(t, client.Actions(), "the up to date cached object is neither read nor applied")
+
+	source.Spec.GzipJson = []byte("changed gzipped dashboard")
+	source.Generation++
+	require.NoError(t, controller.reconcileGrafanaDashboard(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDashboardKind, source), false))
+	actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaDashboards("product-a").Get(context.Background(), "sample", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, source.Spec.GzipJson, actual.Spec.GzipJson, "the changed source is converted and applied")
+}
+
+func TestSyncGrafanaFolderRecordsConvertedObjectsOnSource(t *testing.T) {
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"},
+		Spec:       v1alpha1.GrafanaFolderSpec{FolderName: "converted"},
+	}
+	sourceClient := v1alpha1fake.NewSimpleClientset(source)
+	informerFactory := v1alpha1informers.NewSharedInformerFactory(sourceClient, 0)
+	require.NoError(t, informerFactory.Integreatly().V1alpha1().GrafanaFolders().Informer().GetStore().Add(source))
+	controller := &ConverterController{
+		log:                     logr.Discard(),
+		v1alpha1clientset:       sourceClient,
+		v1beta1clientset:        newFakeV1beta1Clientset(),
+		v1alpha1InformerFactory: []v1alpha1informers.SharedInformerFactory{informerFactory},
+	}
+
+	require.NoError(t, controller.syncGrafanaFolder(context.Background(), "product-a/sample-folder"))
+
+	actual, err := sourceClient.IntegreatlyV1alpha1().GrafanaFolders("product-a").Get(context.Background(), "sample-folder", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.JSONEq(t,
+		`[{"apiVersion":"grafana.integreatly.org/v1beta1","kind":"GrafanaFolder","namespace":"product-a","name":"sample-folder"}]`,
+		actual.Annotations[convertedObjectsAnnotationKey])
+	// references on the source are not propagated to converted objects
+	assert.NotContains(t, controller.convertGrafanaFolder(actual, controller.place(v1alpha1.GrafanaFolderKind, actual)).Annotations, convertedObjectsAnnotationKey)
+
+	patch, err := convertedObjectsPatch(actual, "GrafanaFolder", actual.Namespace, []string{"sample-folder"})
+	require.NoError(t, err)
+	assert.Nil(t, patch, "recorded references must not be patched again")
+}
@@ -0,0 +1,223 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// syncFunc converts v1alpha1 object with the namespace/name key,
+// returned error means that the key has to be converted again later
+type syncFunc func(ctx context.Context, key string) error
+
+// permanentError is a conversion error which retries can not fix, e.g. an invalid source spec
+// or a conflict with an object which is not managed by the converter
+type permanentError struct {
+	error
+}
+
+func (e permanentError) Unwrap() error {
+	return e.error
+}
+
+// permanent marks the conversion error as one which is not retried
+func permanent(err error) error {
+	return permanentError{err}
+}
+
+// isPermanent reports whether the error must not be retried, joined errors are retried if any of them is not permanent
+func isPermanent(err error) bool {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, err := range joined.Unwrap() {
+			if !isPermanent(err) {
+				return false
+			}
+		}
+		return true
+	}
+	var permanentErr permanentError
+	return errors.As(err, &permanentErr)
+}
+
+// kindQueue is a rate limited work queue of v1alpha1 object keys of one kind
+type kindQueue struct {
+	kind       string
+	workers    int
+	strategy   SyncStrategy
+	queue      workqueue.TypedRateLimitingInterface[string]
+	sync       syncFunc
+	log        logr.Logger
+	tombstones sync.Map
+	// changed holds keys enqueued because their v1alpha1 object changed,
+	// other keys were enqueued only because their converted objects drifted
+	changed   sync.Map
+	driftOnly sync.Map
+	// synced report whether informers of the kind delivered their initial state to the queue
+	synced []cache.InformerSynced
+	// busy counts workers processing keys, progress is the time in nanoseconds a worker last started or finished a key
+	busy     atomic.Int32
+	progress atomic.Int64
+	// stopping is set when the queue is shut down, waiting keys are dropped instead of being converted
+	stopping atomic.Bool
+	cancel   context.CancelFunc
+}
+
+func newKindQueue(kind string, workers int, strategy SyncStrategy, sync syncFunc, log logr.Logger) *kindQueue {
+	if workers < 1 {
+		workers = 1
+	}
+	return &kindQueue{
+		kind:     kind,
+		workers:  workers,
+		strategy: strategy,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: strings.ToLower(kind)},
+		),
+		sync: sync,
+		log:  log.WithValues("kind", kind),
+	}
+}
+
+// eventHandler enqueues keys of v1alpha1 objects changed in informer cache
+func (q *kindQueue) eventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: q.enqueue,
+		UpdateFunc: func(old, new interface{}) {
+			oldObject, oldOk := old.(metav1.Object)
+			newObject, newOk := new.(metav1.Object)
+			// periodic resync delivers unchanged objects and status updates, including the conversion status
+			// written by the converter, change nothing to convert, only the mirror strategy has to check them
+			if oldOk && newOk && !q.strategy.enforcesState() &&
+				(oldObject.GetResourceVersion() == newObject.GetResourceVersion() || onlyStatusChanged(oldObject, newObject)) {
+				return
+			}
+			q.enqueue(new)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj); err == nil {
+				q.tombstones.Store(key, sourceFromTombstone(obj))
+			}
+			q.enqueue(obj)
+		},
+	}
+}
+
+func (q *kindQueue) enqueue(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		q.log.Error(err, "cannot get key of object")
+		return
+	}
+	q.changed.Store(key, true)
+	q.queue.Add(key)
+}
+
+// enqueueDrift enqueues the key of v1alpha1 object whose converted object was changed or deleted
+func (q *kindQueue) enqueueDrift(key string) {
+	q.queue.Add(key)
+}
+
+// lastState returns the last known state of the deleted object with the key
+func (q *kindQueue) lastState(key string) (interface{}, bool) {
+	if q == nil {
+		return nil, false
+	}
+	return q.tombstones.Load(key)
+}
+
+// isDriftOnly reports whether the key being processed was enqueued only because its converted object drifted
+func (q *kindQueue) isDriftOnly(key string) bool {
+	if q == nil {
+		return false
+	}
+	_, ok := q.driftOnly.Load(key)
+	return ok
+}
+
+// run starts workers which process the queue until the context is done
+func (q *kindQueue) run(ctx context.Context) {
+	ctx, q.cancel = context.WithCancel(ctx)
+	for i := 0; i < q.workers; i++ {
+		go wait.UntilWithContext(ctx, q.worker, time.Second)
+	}
+}
+
+func (q *kindQueue) worker(ctx context.Context) {
+	for q.processNextItem(ctx) {
+	}
+}
+
+func (q *kindQueue) processNextItem(ctx context.Context) bool {
+	key, shutdown := q.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer q.queue.Done(key)
+	if q.stopping.Load() {
+		return true
+	}
+	q.busy.Add(1)
+	q.progress.Store(time.Now().UnixNano())
+	defer func() {
+		q.busy.Add(-1)
+		q.progress.Store(time.Now().UnixNano())
+	}()
+
+	// the queue never processes the same key concurrently
+	_, changed := q.changed.LoadAndDelete(key)
+	if !changed {
+		q.driftOnly.Store(key, true)
+		defer q.driftOnly.Delete(key)
+	}
+
+	if err := q.sync(ctx, key); err != nil && !isPermanent(err) {
+		q.log.Error(err, fmt.Sprintf("cannot convert %s, retrying", key), "retries", q.queue.NumRequeues(key))
+		if changed {
+			q.changed.Store(key, true)
+		}
+		q.queue.AddRateLimited(key)
+		return true
+	} else if err != nil {
+		q.log.Error(err, fmt.Sprintf("cannot convert %s", key))
+	}
+	q.queue.Forget(key)
+	q.tombstones.Delete(key)
+	return true
+}
+
+// hasSynced reports whether informers of the kind delivered their initial state to the queue
+func (q *kindQueue) hasSynced() bool {
+	for _, synced := range q.synced {
+		if !synced() {
+			return false
+		}
+	}
+	return true
+}
+
+// stalled reports whether all workers have been processing keys without finishing any for longer than timeout
+// while other keys are waiting in the queue
+func (q *kindQueue) stalled(timeout time.Duration) bool {
+	return int(q.busy.Load()) >= q.workers && q.queue.Len() > 0 &&
+		time.Since(time.Unix(0, q.progress.Load())) > timeout
+}
+
+// shutDown stops workers, it returns when keys being converted are processed
+func (q *kindQueue) shutDown() {
+	q.stopping.Store(true)
+	q.queue.ShutDownWithDrain()
+	if q.cancel != nil {
+		q.cancel()
+	}
+}
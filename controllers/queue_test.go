@@ -0,0 +1,94 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestKindQueueRequeuesFailedKeys(t *testing.T) {
+	attempts := 0
+	queue := newKindQueue(v1alpha1.GrafanaDashboardKind, 1, SyncStrategySync, func(context.Context, string) error {
+		attempts++
+		if attempts == 1 {
+			return errors.New("API server is unavailable")
+		}
+		return nil
+	}, logr.Discard())
+	defer queue.shutDown()
+
+	queue.eventHandler().OnAdd(&v1alpha1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-dashboard", Namespace: "product-a"},
+	}, false)
+
+	require.True(t, queue.processNextItem(context.Background()))
+	assert.Equal(t, 1, queue.queue.NumRequeues("product-a/sample-dashboard"))
+
+	// the failed key comes back after the rate limiter delay
+	require.True(t, queue.processNextItem(context.Background()))
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, 0, queue.queue.NumRequeues("product-a/sample-dashboard"))
+}
+
+func TestKindQueueSkipsResyncWithoutChanges(t *testing.T) {
+	source := &v1alpha1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-dashboard", Namespace: "product-a", ResourceVersion: "1"},
+	}
+	for _, tc := range []struct {
+		strategy SyncStrategy
+		queued   int
+	}{
+		{strategy: SyncStrategySync, queued: 0},
+		{strategy: SyncStrategyMirror, queued: 1},
+	} {
+		t.Run(string(tc.strategy), func(t *testing.T) {
+			queue := newKindQueue(v1alpha1.GrafanaDashboardKind, 1, tc.strategy, nil, logr.Discard())
+			defer queue.shutDown()
+
+			queue.eventHandler().OnUpdate(source, source.DeepCopy())
+
+			assert.Equal(t, tc.queued, queue.queue.Len())
+		})
+	}
+}
+
+func TestSyncGrafanaDatasourceDeletesByLastKnownState(t *testing.T) {
+	// converted before the source annotation was introduced, so it is found only by name
+	existing := &v1beta1.GrafanaDatasource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "product-a-prometheus",
+			Namespace: "product-a",
+			Labels:    map[string]string{converterManagedLabel: converterManagedValue},
+		},
+	}
+	client := newFakeV1beta1Clientset(existing)
+	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client, queues: map[string]*kindQueue{}}
+	controller.setConfig(ConverterConfig{DeletionPolicy: DeletionPolicies{Datasource: DeletionPolicyDelete}})
+	queue := controller.addQueue(v1alpha1.GrafanaDataSourceKind, 1, controller.syncGrafanaDatasource)
+	defer queue.shutDown()
+
+	queue.eventHandler().OnDelete(cache.DeletedFinalStateUnknown{
+		Key: "product-a/sample",
+		Obj: &v1alpha1.GrafanaDataSource{
+			ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "product-a"},
+			Spec: v1alpha1.GrafanaDataSourceSpec{
+				Datasources: []v1alpha1.GrafanaDataSourceFields{{Name: "Prometheus"}},
+			},
+		},
+	})
+	require.True(t, queue.processNextItem(context.Background()))
+
+	actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaDatasources("product-a").List(context.Background(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, actual.Items)
+	_, ok := queue.lastState("product-a/sample")
+	assert.False(t, ok)
+}
@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchConfig reloads the configuration when the configuration file or the ConverterConfiguration changes
+// until the context is done. The directory of the file is watched, because ConfigMap volumes replace files
+// by swapping a symlink.
+func (c *ConverterController) watchConfig(ctx context.Context) {
+	var (
+		fileEvents chan fsnotify.Event
+		fileErrors chan error
+	)
+	if watcher := c.newConfigFileWatcher(); watcher != nil {
+		defer func() {
+			_ = watcher.Close()
+		}()
+		fileEvents, fileErrors = watcher.Events, watcher.Errors
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.configurationChanged:
+			c.reload(ctx)
+		case event, ok := <-fileEvents:
+			if !ok {
+				fileEvents = nil
+				continue
+			}
+			if event.Has(fsnotify.Chmod) {
+				continue
+			}
+			c.reload(ctx)
+		case err, ok := <-fileErrors:
+			if !ok {
+				fileErrors = nil
+				continue
+			}
+			c.log.Error(err, "error watching grafana converter configuration file", "path", c.configPath)
+		}
+	}
+}
+
+// newConfigFileWatcher returns the watcher of the configuration file directory or nil if it can not be watched
+func (c *ConverterController) newConfigFileWatcher() *fsnotify.Watcher {
+	if c.configPath == "" {
+		return nil
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		c.log.Error(err, "cannot watch grafana converter configuration file, it is not reloaded")
+		return nil
+	}
+	if err = watcher.Add(filepath.Dir(c.configPath)); err != nil {
+		_ = watcher.Close()
+		c.log.Error(err, "cannot watch grafana converter configuration file, it is not reloaded", "path", c.configPath)
+		return nil
+	}
+	return watcher
+}
+
+// reload reads the configuration from the ConverterConfiguration or the configuration file and applies it
+// if it is valid and differs from the current one. Every change of the configuration changes the conversion hash
+// of converted objects, so all v1alpha1 objects are enqueued again by the informers started for the new configuration.
+func (c *ConverterController) reload(ctx context.Context) {
+	configuration := c.configuration()
+	if configuration == nil && !c.resourceConfigured {
+		if _, err := os.Stat(c.configPath); err != nil {
+			// the file is being replaced, a missing file does not disable the converter at runtime
+			return
+		}
+	}
+	// the configuration file is read again when the ConverterConfiguration is deleted
+	c.resourceConfigured = configuration != nil
+	conf, err := c.loadConfig(configuration)
+	if err != nil {
+		if configuration != nil {
+			c.log.Error(err, "invalid ConverterConfiguration, keeping the current configuration", "name", configuration.Name)
+		} else {
+			c.log.Error(err, "invalid grafana converter configuration, keeping the current one", "path", c.configPath)
+		}
+		c.setConfigErr(err)
+		c.updateConfigurationStatus(ctx, configuration, c.config(), err)
+		return
+	}
+	if reflect.DeepEqual(*conf, c.config()) {
+		c.setConfigErr(nil)
+		c.updateConfigurationStatus(ctx, configuration, *conf, nil)
+		return
+	}
+
+	c.log.Info(fmt.Sprintf("reloading converter config: %+v", *conf))
+	previous := c.conf.Swap(conf)
+	if err = c.watch(ctx, *conf); err != nil {
+		// the previous informers keep running, so the previous configuration is kept with them
+		// and the next reload watches with the new one again
+		c.log.Error(err, "cannot watch grafana objects with reloaded configuration, keeping the current configuration")
+		err = fmt.Errorf("cannot watch grafana objects: %w", err)
+		c.conf.Store(previous)
+	}
+	c.setConfigErr(err)
+	c.updateConfigurationStatus(ctx, configuration, c.config(), err)
+}
@@ -0,0 +1,160 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+func writeConfig(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func TestReloadReplacesConvertedKinds(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "parameters.yaml")
+	writeConfig(t, path, "enable: true\nfolder: true\n")
+	client := newFakeV1beta1Clientset()
+	controller, err := NewGrafanaConverterController(context.Background(), path, v1alpha1fake.NewSimpleClientset(
+		&v1alpha1.GrafanaFolder{ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"}},
+		&v1alpha1.GrafanaDashboard{ObjectMeta: metav1.ObjectMeta{Name: "sample-dashboard", Namespace: "product-a"}},
+	), client, nil, nil, nil, 0, logr.Discard())
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, controller.watch(ctx, controller.config()))
+	folderQueue := controller.queue(v1alpha1.GrafanaFolderKind)
+	require.NotNil(t, folderQueue)
+	assert.Nil(t, controller.queue(v1alpha1.GrafanaDashboardKind))
+	assert.Eventually(t, func() bool {
+		_, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders("product-a").Get(ctx, "sample-folder", metav1.GetOptions{})
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// output settings re-convert sources of running queues
+	writeConfig(t, path, "enable: true\nfolder: true\ninstanceSelector:\n  matchLabels:\n    app: grafana\n")
+	controller.reload(ctx)
+	assert.Same(t, folderQueue, controller.queue(v1alpha1.GrafanaFolderKind))
+	assert.Eventually(t, func() bool {
+		folder, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders("product-a").Get(ctx, "sample-folder", metav1.GetOptions{})
+		return err == nil && folder.Spec.InstanceSelector != nil && folder.Spec.InstanceSelector.MatchLabels["app"] == "grafana"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	writeConfig(t, path, "enable: true\ndashboard: true\n")
+	controller.reload(ctx)
+	assert.Nil(t, controller.queue(v1alpha1.GrafanaFolderKind))
+	assert.True(t, folderQueue.queue.ShuttingDown())
+	require.NotNil(t, controller.queue(v1alpha1.GrafanaDashboardKind))
+	assert.Eventually(t, func() bool {
+		_, err := client.GrafanaIntegreatlyV1beta1().GrafanaDashboards("product-a").Get(ctx, "sample-dashboard", metav1.GetOptions{})
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestReloadKeepsConfigurationWhenInvalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "parameters.yaml")
+	writeConfig(t, path, "strategy: sync\n")
+	controller, err := NewGrafanaConverterController(context.Background(), path, v1alpha1fake.NewSimpleClientset(), newFakeV1beta1Clientset(), nil, nil, nil, 0, logr.Discard())
+	require.NoError(t, err)
+
+	writeConfig(t, path, "strategy: unknown\n")
+	controller.reload(context.Background())
+	assert.Equal(t, SyncStrategySync, controller.config().Strategy)
+
+	require.NoError(t, os.Remove(path))
+	controller.reload(context.Background())
+	assert.Equal(t, SyncStrategySync, controller.config().Strategy, "a missing file must not reset the configuration")
+}
+
+func TestReadyzCheckFailsWhenReloadFails(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "parameters.yaml")
+	writeConfig(t, path, "strategy: sync\n")
+	controller, err := NewGrafanaConverterController(context.Background(), path, v1alpha1fake.NewSimpleClientset(), newFakeV1beta1Clientset(), nil, nil, nil, 0, logr.Discard())
+	require.NoError(t, err)
+	check := controller.ReadyzChecks()["converter-config"]
+	require.NotNil(t, check)
+	assert.NoError(t, check(nil))
+
+	writeConfig(t, path, "strategy: unknown\n")
+	controller.reload(context.Background())
+	assert.ErrorContains(t, check(nil), "the converter keeps its previous configuration: strategy:")
+
+	writeConfig(t, path, "strategy: sync\n")
+	controller.reload(context.Background())
+	assert.NoError(t, check(nil), "the configuration is ready again once it is valid")
+}
+
+func TestReloadKeepsConfigurationWhenWatchFails(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "parameters.yaml")
+	writeConfig(t, path, "enable: true\nfolder: true\n")
+	kubeClient := kubefake.NewClientset()
+	controller, err := NewGrafanaConverterController(context.Background(), path, v1alpha1fake.NewSimpleClientset(), newFakeV1beta1Clientset(), nil, kubeClient, nil, 0, logr.Discard())
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, controller.watch(ctx, controller.config()))
+	check := controller.ReadyzChecks()["converter-config"]
+	require.NotNil(t, check)
+
+	var unavailable atomic.Bool
+	kubeClient.PrependReactor("list", "configmaps", func(clienttesting.Action) (bool, runtime.Object, error) {
+		if unavailable.Load() {
+			return true, nil, errors.New("connection refused")
+		}
+		return false, nil, nil
+	})
+	unavailable.Store(true)
+	writeConfig(t, path, "enable: true\nfolder: true\ndashboard: true\n")
+	controller.reload(ctx)
+	assert.ErrorContains(t, check(nil), "cannot watch grafana objects")
+	assert.False(t, controller.config().Dashboard, "the configuration of running informers is kept")
+
+	controller.reload(ctx)
+	assert.ErrorContains(t, check(nil), "cannot watch grafana objects", "the failed configuration is watched again on the next reload")
+
+	unavailable.Store(false)
+	controller.reload(ctx)
+	assert.NoError(t, check(nil))
+	assert.True(t, controller.config().Dashboard)
+	assert.NotNil(t, controller.queue(v1alpha1.GrafanaDashboardKind))
+}
+
+func TestWatchConfigReloadsChangedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "parameters.yaml")
+	writeConfig(t, path, "strategy: sync\n")
+	controller, err := NewGrafanaConverterController(context.Background(), path, v1alpha1fake.NewSimpleClientset(), newFakeV1beta1Clientset(), nil, nil, nil, 0, logr.Discard())
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	watched := make(chan struct{})
+	go func() {
+		defer close(watched)
+		controller.watchConfig(ctx)
+	}()
+	defer func() {
+		cancel()
+		<-watched
+	}()
+
+	// ConfigMap volumes replace the file by renaming a new one over it
+	assert.Eventually(t, func() bool {
+		next := filepath.Join(filepath.Dir(path), "..parameters.yaml.tmp")
+		writeConfig(t, next, "strategy: createOnly\n")
+		require.NoError(t, os.Rename(next, path))
+		return controller.config().Strategy == SyncStrategyCreateOnly
+	}, 5*time.Second, 50*time.Millisecond)
+}
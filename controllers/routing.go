@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ruleKinds are kinds of v1alpha1 objects by their keys in instance selector rules
+var ruleKinds = map[string]string{
+	"dashboard":    v1alpha1.GrafanaDashboardKind,
+	"datasource":   v1alpha1.GrafanaDataSourceKind,
+	"folder":       v1alpha1.GrafanaFolderKind,
+	"notification": v1alpha1.GrafanaNotificationChannelKind,
+}
+
+// InstanceSelectorRule routes v1alpha1 objects to Grafana instances, it matches objects which meet all its conditions
+type InstanceSelectorRule struct {
+	// Namespaces are namespaces of matched objects, empty matches objects of all namespaces
+	Namespaces []string `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
+	// Kinds are kinds of matched objects: dashboard, datasource, folder or notification, empty matches all kinds
+	Kinds []string `json:"kinds,omitempty" yaml:"kinds,omitempty"`
+	// Selector is the label selector of matched objects, empty matches all objects
+	Selector *metav1.LabelSelector `json:"selector,omitempty" yaml:"selector,omitempty"`
+	// InstanceSelector selects Grafana instances of objects converted from matched objects
+	InstanceSelector *metav1.LabelSelector `json:"instanceSelector" yaml:"instanceSelector"`
+}
+
+// validate returns all problems of the rule
+func (r InstanceSelectorRule) validate() []error {
+	var errs []error
+	if r.InstanceSelector == nil {
+		errs = append(errs, errors.New("instanceSelector: must be set"))
+	} else if _, err := metav1.LabelSelectorAsSelector(r.InstanceSelector); err != nil {
+		errs = append(errs, fmt.Errorf("instanceSelector: %w", err))
+	}
+	return append(errs, validateSourceConditions(r.Namespaces, r.Kinds, r.Selector)...)
+}
+
+// matches reports whether the rule matches the v1alpha1 object of the kind
+func (r InstanceSelectorRule) matches(kind string, source metav1.Object) bool {
+	return matchesSource(r.Namespaces, r.Kinds, r.Selector, kind, source)
+}
+
+// validateSourceConditions returns problems of conditions rules match v1alpha1 objects with
+func validateSourceConditions(namespaces, kinds []string, selector *metav1.LabelSelector) []error {
+	var errs []error
+	if selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(selector); err != nil {
+			errs = append(errs, fmt.Errorf("selector: %w", err))
+		}
+	}
+	for _, kind := range kinds {
+		if _, ok := ruleKinds[kind]; !ok {
+			errs = append(errs, fmt.Errorf("kinds: unknown kind %q, must be one of: dashboard, datasource, folder, notification", kind))
+		}
+	}
+	for _, namespace := range namespaces {
+		for _, msg := range validation.IsDNS1123Label(namespace) {
+			errs = append(errs, fmt.Errorf("namespaces: %q: %s", namespace, msg))
+		}
+	}
+	return errs
+}
+
+// matchesSource reports whether the v1alpha1 object of the kind is in one of the namespaces, is of one of the kinds
+// and matches the selector, empty conditions match all objects
+func matchesSource(namespaces, kinds []string, selector *metav1.LabelSelector, kind string, source metav1.Object) bool {
+	if len(namespaces) > 0 && !slices.Contains(namespaces, source.GetNamespace()) {
+		return false
+	}
+	if len(kinds) > 0 && !slices.ContainsFunc(kinds, func(key string) bool { return ruleKinds[key] == kind }) {
+		return false
+	}
+	if selector == nil {
+		return true
+	}
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	return err == nil && labelSelector.Matches(labels.Set(source.GetLabels()))
+}
+
+// validateInstanceSelectorRules returns problems of all rules joined in one error
+func validateInstanceSelectorRules(rules []InstanceSelectorRule) error {
+	var errs []error
+	for i, rule := range rules {
+		for _, err := range rule.validate() {
+			errs = append(errs, fmt.Errorf("instanceSelectorRules[%d].%w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// routedInstanceSelector returns the instance selector of the first rule matching the v1alpha1 object of the kind,
+// or nil if no rule matches it
+func routedInstanceSelector(rules []InstanceSelectorRule, kind string, source metav1.Object) *metav1.LabelSelector {
+	for _, rule := range rules {
+		if rule.matches(kind, source) {
+			return rule.InstanceSelector
+		}
+	}
+	return nil
+}
+
+// instanceSelectorChanged reports whether the converted object moves to other Grafana instances.
+// Instance selectors of v1beta1 objects are immutable, so such objects have to be recreated.
+func instanceSelectorChanged(existing, converted *metav1.LabelSelector) bool {
+	return !apiequality.Semantic.DeepEqual(existing, converted)
+}
+
+// errRecreating is returned while a converted object moved to other Grafana instances is being deleted
+var errRecreating = errors.New("converted object is being deleted to be created with another instance selector")
+
+// recreateConverted deletes the existing converted object whose instance selector changed, so it can be created again.
+// It returns errRecreating if the object is still there after the deletion, e.g. while finalizers remove it from Grafana,
+// the conversion is retried until it is gone.
+func recreateConverted(ctx context.Context, l logr.Logger, kind string, existing metav1.Object,
+	deleteFunc func(ctx context.Context, options metav1.DeleteOptions) error, getFunc func(ctx context.Context) error) error {
+	if existing.GetDeletionTimestamp() == nil {
+		uid := existing.GetUID()
+		if err := deleteFunc(ctx, metav1.DeleteOptions{Preconditions: &metav1.Preconditions{UID: &uid}}); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("cannot delete %s to change its instance selector: %w", kind, err)
+		}
+		l.Info(fmt.Sprintf("%s %v/%v has been deleted to be created with another instance selector", kind, existing.GetNamespace(), existing.GetName()))
+	}
+	err := getFunc(ctx)
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil
+	case err != nil:
+		return fmt.Errorf("cannot get %s: %w", kind, err)
+	}
+	return fmt.Errorf("%s %v/%v: %w", kind, existing.GetNamespace(), existing.GetName(), errRecreating)
+}
@@ -0,0 +1,110 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	v1alpha1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestInstanceSelectorRules(t *testing.T) {
+	_, err := ParseConfig([]byte(`
+instanceSelectorRules:
+- kinds: [dashboards]
+  namespaces: [Tenant_A]
+- selector:
+    matchLabels:
+      team: "a b"
+  instanceSelector:
+    matchLabels:
+      app: grafana
+`))
+	assert.ErrorContains(t, err, "instanceSelectorRules[0].instanceSelector: must be set")
+	assert.ErrorContains(t, err, `instanceSelectorRules[0].kinds: unknown kind "dashboards"`)
+	assert.ErrorContains(t, err, `instanceSelectorRules[0].namespaces: "Tenant_A"`)
+	assert.ErrorContains(t, err, "instanceSelectorRules[1].selector:")
+
+	conf, err := ParseConfig([]byte(`
+instanceSelector:
+  matchLabels:
+    app: grafana-ops
+instanceSelectorRules:
+- namespaces: [tenant-a]
+  instanceSelector:
+    matchLabels:
+      app: grafana-tenant
+- kinds: [dashboard]
+  selector:
+    matchLabels:
+      audience: business
+  instanceSelector:
+    matchLabels:
+      app: grafana-business
+`))
+	require.NoError(t, err)
+	controller := &ConverterController{log: logr.Discard()}
+	controller.setConfig(*conf)
+	instance := func(kind string, source metav1.Object) string {
+		return controller.place(kind, source).conf.InstanceSelector.MatchLabels["app"]
+	}
+	business := metav1.ObjectMeta{Namespace: "product-a", Labels: map[string]string{"audience": "business"}}
+
+	assert.Equal(t, "grafana-tenant", instance(v1alpha1.GrafanaFolderKind, &metav1.ObjectMeta{Namespace: "tenant-a"}))
+	assert.Equal(t, "grafana-business", instance(v1alpha1.GrafanaDashboardKind, &business))
+	assert.Equal(t, "grafana-ops", instance(v1alpha1.GrafanaFolderKind, &business), "sources matching no rule get the global instance selector")
+	business.Annotations = map[string]string{instanceSelectorAnnotationKey: "app=grafana-b"}
+	assert.Equal(t, "grafana-b", instance(v1alpha1.GrafanaDashboardKind, &business), "the annotation takes precedence over rules")
+}
+
+func TestSyncGrafanaFolderRecreatesMovedObject(t *testing.T) {
+	existing := &v1beta1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sample-folder",
+			Namespace: "tenant-a",
+			UID:       "previous-uid",
+			Labels:    map[string]string{converterManagedLabel: converterManagedValue},
+		},
+		Spec: v1beta1.GrafanaFolderSpec{
+			Title:            "Sample",
+			InstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "grafana-ops"}},
+		},
+	}
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "tenant-a"},
+		Spec:       v1alpha1.GrafanaFolderSpec{FolderName: "Sample"},
+	}
+	client := newFakeV1beta1Clientset(existing)
+	informerFactory := v1alpha1informers.NewSharedInformerFactory(v1alpha1fake.NewSimpleClientset(), 0)
+	require.NoError(t, informerFactory.Integreatly().V1alpha1().GrafanaFolders().Informer().GetStore().Add(source))
+	controller := &ConverterController{
+		log:                     logr.Discard(),
+		v1alpha1clientset:       v1alpha1fake.NewSimpleClientset(source),
+		v1beta1clientset:        client,
+		v1alpha1InformerFactory: []v1alpha1informers.SharedInformerFactory{informerFactory},
+	}
+	controller.setConfig(ConverterConfig{
+		InstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "grafana-ops"}},
+		InstanceSelectorRules: []InstanceSelectorRule{{
+			Namespaces:       []string{"tenant-a"},
+			InstanceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "grafana-tenant"}},
+		}},
+	})
+
+	require.NoError(t, controller.syncGrafanaFolder(context.Background(), "tenant-a/sample-folder"))
+
+	folder, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders("tenant-a").Get(context.Background(), "sample-folder", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"app": "grafana-tenant"}, folder.Spec.InstanceSelector.MatchLabels)
+	deleted := false
+	for _, action := range client.Actions() {
+		deleted = deleted || action.GetVerb() == "delete"
+	}
+	assert.True(t, deleted, "the immutable instance selector is changed by recreating the object")
+}
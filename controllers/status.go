@@ -0,0 +1,28 @@
+package controllers
+
+import (
+	"fmt"
+
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+)
+
+// conversionStatus returns the conversion status of a v1alpha1 object after its conversion finished with err,
+// converted are names of v1beta1 objects the object is converted to
+func conversionStatus(previous *v1alpha1.ConversionStatus, generation int64, converted []string, err error) *v1alpha1.ConversionStatus {
+	status := &v1alpha1.ConversionStatus{
+		Phase:              v1alpha1.ConversionPhaseConverted,
+		Message:            fmt.Sprintf("converted to %s", v1beta1.GroupVersion.String()),
+		ConvertedObjects:   converted,
+		ObservedGeneration: generation,
+	}
+	if previous != nil {
+		status.LastError = previous.LastError
+	}
+	if err != nil {
+		status.Phase = v1alpha1.ConversionPhaseFailed
+		status.Message = err.Error()
+		status.LastError = err.Error()
+	}
+	return status
+}
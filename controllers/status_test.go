@@ -0,0 +1,130 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	v1alpha1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSyncGrafanaFolderWritesConversionStatus(t *testing.T) {
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a", Generation: 3},
+		Spec:       v1alpha1.GrafanaFolderSpec{FolderName: "converted"},
+	}
+	// the converted folder name is taken by an object the converter does not manage
+	client := newFakeV1beta1Clientset(&v1beta1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: source.Name, Namespace: source.Namespace},
+	})
+	sourceClient := v1alpha1fake.NewSimpleClientset(source)
+	informerFactory := v1alpha1informers.NewSharedInformerFactory(sourceClient, 0)
+	store := informerFactory.Integreatly().V1alpha1().GrafanaFolders().Informer().GetStore()
+	require.NoError(t, store.Add(source))
+	controller := &ConverterController{
+		log:                     logr.Discard(),
+		v1alpha1clientset:       sourceClient,
+		v1beta1clientset:        client,
+		v1alpha1InformerFactory: []v1alpha1informers.SharedInformerFactory{informerFactory},
+		queues:                  map[string]*kindQueue{},
+	}
+	queue := controller.addQueue(v1alpha1.GrafanaFolderKind, 1, controller.syncGrafanaFolder)
+	defer queue.shutDown()
+
+	queue.eventHandler().OnAdd(source, false)
+	require.True(t, queue.processNextItem(context.Background()))
+
+	// conflicts with unmanaged objects are not retried
+	assert.Equal(t, 0, queue.queue.Len())
+	failed, err := sourceClient.IntegreatlyV1alpha1().GrafanaFolders(source.Namespace).Get(context.Background(), source.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, failed.Status.Conversion)
+	assert.Equal(t, v1alpha1.ConversionPhaseFailed, failed.Status.Conversion.Phase)
+	assert.Contains(t, failed.Status.Conversion.LastError, errNotManaged.Error())
+	assert.Equal(t, failed.Status.Conversion.Message, failed.Status.Conversion.LastError)
+
+	// the owner allows the converter to adopt the object
+	controller.setConfig(ConverterConfig{AdoptionPolicy: AdoptionPolicyAlways})
+	require.NoError(t, store.Update(failed))
+	require.NoError(t, controller.syncGrafanaFolder(context.Background(), "product-a/sample-folder"))
+
+	converted, err := sourceClient.IntegreatlyV1alpha1().GrafanaFolders(source.Namespace).Get(context.Background(), source.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, &v1alpha1.ConversionStatus{
+		Phase:              v1alpha1.ConversionPhaseConverted,
+		Message:            "converted to grafana.integreatly.org/v1beta1",
+		ConvertedObjects:   []string{"sample-folder"},
+		LastError:          failed.Status.Conversion.LastError,
+		ObservedGeneration: 3,
+	}, converted.Status.Conversion)
+}
+
+func TestSyncGrafanaDatasourceReportsPartiallyFailedConversion(t *testing.T) {
+	source := &v1alpha1.GrafanaDataSource{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample", Namespace: "product-a"},
+		Spec: v1alpha1.GrafanaDataSourceSpec{
+			Datasources: []v1alpha1.GrafanaDataSourceFields{
+				{Name: "Loki"},
+				{Name: "Tempo"},
+			},
+		},
+	}
+	// only the converted Tempo datasource name is taken by an object the converter does not manage
+	client := newFakeV1beta1Clientset(&v1beta1.GrafanaDatasource{
+		ObjectMeta: metav1.ObjectMeta{Name: "product-a-tempo", Namespace: "product-a"},
+	})
+	sourceClient := v1alpha1fake.NewSimpleClientset(source)
+	informerFactory := v1alpha1informers.NewSharedInformerFactory(sourceClient, 0)
+	require.NoError(t, informerFactory.Integreatly().V1alpha1().GrafanaDataSources().Informer().GetStore().Add(source))
+	controller := &ConverterController{
+		log:                     logr.Discard(),
+		v1alpha1clientset:       sourceClient,
+		v1beta1clientset:        client,
+		v1alpha1InformerFactory: []v1alpha1informers.SharedInformerFactory{informerFactory},
+	}
+
+	err := controller.syncGrafanaDatasource(context.Background(), "product-a/sample")
+
+	assert.True(t, isPermanent(err))
+	_, err = client.GrafanaIntegreatlyV1beta1().GrafanaDatasources("product-a").Get(context.Background(), "product-a-loki", metav1.GetOptions{})
+	require.NoError(t, err)
+	actual, err := sourceClient.IntegreatlyV1alpha1().GrafanaDataSources("product-a").Get(context.Background(), "sample", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, actual.Status.Conversion)
+	assert.Equal(t, v1alpha1.ConversionPhaseFailed, actual.Status.Conversion.Phase)
+	assert.Contains(t, actual.Status.Conversion.Message, "product-a/product-a-tempo")
+	assert.Equal(t, []string{"product-a-loki", "product-a-tempo"}, actual.Status.Conversion.ConvertedObjects)
+}
+
+func TestIsPermanentRequiresAllJoinedErrorsToBePermanent(t *testing.T) {
+	invalidSpec := permanent(errors.New("invalid spec"))
+	unavailable := errors.New("API server is unavailable")
+
+	assert.True(t, isPermanent(fmt.Errorf("cannot convert: %w", invalidSpec)))
+	assert.True(t, isPermanent(errors.Join(invalidSpec, permanent(errNotManaged))))
+	assert.False(t, isPermanent(errors.Join(invalidSpec, unavailable)))
+	assert.False(t, isPermanent(unavailable))
+}
+
+func TestKindQueueSkipsStatusUpdates(t *testing.T) {
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a", ResourceVersion: "1", Generation: 1},
+	}
+	statusUpdated := source.DeepCopy()
+	statusUpdated.ResourceVersion = "2"
+	statusUpdated.Status.Conversion = &v1alpha1.ConversionStatus{Phase: v1alpha1.ConversionPhaseConverted}
+	queue := newKindQueue(v1alpha1.GrafanaFolderKind, 1, SyncStrategySync, nil, logr.Discard())
+	defer queue.shutDown()
+
+	queue.eventHandler().OnUpdate(source, statusUpdated)
+
+	assert.Equal(t, 0, queue.queue.Len())
+}
@@ -0,0 +1,92 @@
+package controllers
+
+import (
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SyncStrategy defines how the converter keeps converted objects in sync with v1alpha1 sources
+type SyncStrategy string
+
+const (
+	// SyncStrategyCreateOnly - the converter creates missing objects and never touches existing v1beta1 objects
+	SyncStrategyCreateOnly SyncStrategy = "createOnly"
+	// SyncStrategySync - the converter updates converted objects when the source spec changes, it is the default strategy
+	SyncStrategySync SyncStrategy = "sync"
+	// SyncStrategyMirror - the converter continuously enforces converted objects, reverts manual edits and deletes orphans
+	SyncStrategyMirror SyncStrategy = "mirror"
+	// SyncStrategyOneShot - the converter converts the current inventory once and stops watching
+	SyncStrategyOneShot SyncStrategy = "oneShot"
+
+	// defaultMirrorResyncPeriod is used by the mirror strategy when no resync period is configured
+	defaultMirrorResyncPeriod = 5 * time.Minute
+)
+
+func (s SyncStrategy) validate() error {
+	switch s {
+	case "", SyncStrategyCreateOnly, SyncStrategySync, SyncStrategyMirror, SyncStrategyOneShot:
+		return nil
+	}
+	return fmt.Errorf("unknown strategy %q, must be one of: %q, %q, %q, %q", s, SyncStrategyCreateOnly, SyncStrategySync, SyncStrategyMirror, SyncStrategyOneShot)
+}
+
+func (s SyncStrategy) orDefault() SyncStrategy {
+	if s == "" {
+		return SyncStrategySync
+	}
+	return s
+}
+
+// updatesExisting reports whether the strategy allows to modify existing v1beta1 objects
+func (s SyncStrategy) updatesExisting() bool {
+	return s.orDefault() != SyncStrategyCreateOnly
+}
+
+// enforcesState reports whether converted objects have to be checked even if the source has not changed
+func (s SyncStrategy) enforcesState() bool {
+	return s.orDefault() == SyncStrategyMirror
+}
+
+// deletionPolicy returns the deletion policy of a kind adjusted to the sync strategy
+func (c *ConverterController) deletionPolicy(policy DeletionPolicy) DeletionPolicy {
+	switch c.config().Strategy.orDefault() {
+	case SyncStrategyCreateOnly:
+		return DeletionPolicyOrphan
+	case SyncStrategyMirror:
+		if policy.orDefault() == DeletionPolicyOrphan {
+			return DeletionPolicyDelete
+		}
+	}
+	return policy.orDefault()
+}
+
+// convertedDeletionPolicy returns the deletion policy of objects converted from the v1alpha1 object into the namespace.
+// Objects converted into another namespace or cluster get no owner references, so the converter deletes them itself.
+func (c *ConverterController) convertedDeletionPolicy(policy DeletionPolicy, source metav1.Object, namespace string) DeletionPolicy {
+	policy = c.deletionPolicy(policy)
+	if policy == DeletionPolicyOwnerReference && !c.ownerReferencesAllowed(source, namespace) {
+		return DeletionPolicyDelete
+	}
+	return policy
+}
+
+// metadataDrifted reports whether labels or annotations of the converted object have to be restored,
+// it is checked only by the mirror strategy and when drift detection is enabled
+func (c *ConverterController) metadataDrifted(existing, desired metav1.Object) bool {
+	if !c.config().Strategy.enforcesState() && c.driftPolicy() == DriftPolicyIgnore {
+		return false
+	}
+	return !containsAll(existing.GetLabels(), desired.GetLabels()) ||
+		!containsAll(existing.GetAnnotations(), desired.GetAnnotations())
+}
+
+func containsAll(actual, expected map[string]string) bool {
+	for key, value := range expected {
+		if actualValue, ok := actual[key]; !ok || actualValue != value {
+			return false
+		}
+	}
+	return true
+}
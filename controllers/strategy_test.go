@@ -0,0 +1,171 @@
+package controllers
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1alpha1fake "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned/fake"
+	v1alpha1informers "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/informers/externalversions"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCreateOnlyStrategyDoesNotUpdateExistingDashboard(t *testing.T) {
+	existing := &v1beta1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sample-dashboard",
+			Namespace: "product-a",
+			Labels:    map[string]string{converterManagedLabel: converterManagedValue},
+		},
+		Spec: v1beta1.GrafanaDashboardSpec{Json: "old"},
+	}
+	client := newFakeV1beta1Clientset(existing)
+	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
+	controller.setConfig(ConverterConfig{Strategy: SyncStrategyCreateOnly})
+	source := &v1alpha1.GrafanaDashboard{
+		ObjectMeta: metav1.ObjectMeta{Name: existing.Name, Namespace: existing.Namespace},
+		Spec:       v1alpha1.GrafanaDashboardSpec{Json: "new"},
+	}
+
+	require.NoError(t, controller.reconcileGrafanaDashboard(context.Background(), logr.Discard(), source, controller.place(v1alpha1.GrafanaDashboardKind, source), false))
+
+	actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaDashboards(existing.Namespace).Get(
+		context.Background(), existing.Name, metav1.GetOptions{},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "old", actual.Spec.Json)
+}
+
+func TestStrategyIsReadFromPlacement(t *testing.T) {
+	existing := &v1beta1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sample-folder",
+			Namespace: "product-a",
+			Labels:    map[string]string{converterManagedLabel: converterManagedValue},
+		},
+		Spec: v1beta1.GrafanaFolderSpec{Title: "old"},
+	}
+	client := newFakeV1beta1Clientset(existing)
+	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
+	controller.setConfig(ConverterConfig{Strategy: SyncStrategyCreateOnly})
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: existing.Name, Namespace: existing.Namespace},
+		Spec:       v1alpha1.GrafanaFolderSpec{FolderName: "new"},
+	}
+	p := controller.place(v1alpha1.GrafanaFolderKind, source)
+
+	// a reload during the sync does not change the strategy the object is converted with
+	controller.setConfig(ConverterConfig{Strategy: SyncStrategySync})
+	require.NoError(t, controller.reconcileGrafanaFolder(context.Background(), logr.Discard(), source, p, false))
+
+	actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders(existing.Namespace).Get(
+		context.Background(), existing.Name, metav1.GetOptions{},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "old", actual.Spec.Title)
+}
+
+func TestMirrorStrategyRevertsManualEditsOnResync(t *testing.T) {
+	source := &v1alpha1.GrafanaFolder{
+		ObjectMeta: metav1.ObjectMeta{Name: "sample-folder", Namespace: "product-a"},
+		Spec:       v1alpha1.GrafanaFolderSpec{FolderName: "converted"},
+	}
+	for _, tc := range []struct {
+		strategy SyncStrategy
+		expected string
+	}{
+		{strategy: SyncStrategySync, expected: "edited"},
+		{strategy: SyncStrategyMirror, expected: "converted"},
+	} {
+		t.Run(string(tc.strategy), func(t *testing.T) {
+			edited := &v1beta1.GrafanaFolder{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      source.Name,
+					Namespace: source.Namespace,
+					Labels:    map[string]string{converterManagedLabel: converterManagedValue},
+				},
+				Spec: v1beta1.GrafanaFolderSpec{Title: "edited"},
+			}
+			client := newFakeV1beta1Clientset(edited)
+			sourceClient := v1alpha1fake.NewSimpleClientset(source)
+			informerFactory := v1alpha1informers.NewSharedInformerFactory(sourceClient, 0)
+			require.NoError(t, informerFactory.Integreatly().V1alpha1().GrafanaFolders().Informer().GetStore().Add(source))
+			controller := &ConverterController{
+				log:                     logr.Discard(),
+				v1alpha1clientset:       sourceClient,
+				v1beta1clientset:        client,
+				v1alpha1InformerFactory: []v1alpha1informers.SharedInformerFactory{informerFactory},
+			}
+			controller.setConfig(ConverterConfig{Strategy: tc.strategy})
+			queue := newKindQueue(v1alpha1.GrafanaFolderKind, 1, tc.strategy, controller.syncGrafanaFolder, logr.Discard())
+			defer queue.shutDown()
+
+			// periodic resync delivers the same object as an update
+			queue.eventHandler().OnUpdate(source, source.DeepCopy())
+			for queue.queue.Len() > 0 {
+				queue.processNextItem(context.Background())
+			}
+
+			actual, err := client.GrafanaIntegreatlyV1beta1().GrafanaFolders(source.Namespace).Get(
+				context.Background(), source.Name, metav1.GetOptions{},
+			)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual.Spec.Title)
+		})
+	}
+}
+
+func TestStrategyAdjustsDeletionPolicy(t *testing.T) {
+	for _, tc := range []struct {
+		strategy SyncStrategy
+		policy   DeletionPolicy
+		expected DeletionPolicy
+	}{
+		{strategy: "", policy: "", expected: DeletionPolicyOrphan},
+		{strategy: SyncStrategySync, policy: DeletionPolicyDelete, expected: DeletionPolicyDelete},
+		{strategy: SyncStrategyCreateOnly, policy: DeletionPolicyDelete, expected: DeletionPolicyOrphan},
+		{strategy: SyncStrategyMirror, policy: "", expected: DeletionPolicyDelete},
+		{strategy: SyncStrategyMirror, policy: DeletionPolicyOwnerReference, expected: DeletionPolicyOwnerReference},
+	} {
+		controller := &ConverterController{}
+		controller.setConfig(ConverterConfig{Strategy: tc.strategy})
+
+		assert.Equal(t, tc.expected, controller.deletionPolicy(tc.policy), "strategy %q, policy %q", tc.strategy, tc.policy)
+	}
+}
+
+func TestMirrorStrategyDeletesConvertedContactPointOfDeletedSource(t *testing.T) {
+	existing := &v1beta1.GrafanaContactPoint{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sample-contact-point",
+			Namespace: "product-a",
+			Labels:    map[string]string{converterManagedLabel: converterManagedValue},
+		},
+	}
+	client := newFakeV1beta1Clientset(existing)
+	controller := &ConverterController{log: logr.Discard(), v1beta1clientset: client}
+	controller.setConfig(ConverterConfig{Strategy: SyncStrategyMirror})
+
+	require.NoError(t, controller.syncGrafanaNotificationChannel(context.Background(), "product-a/sample-contact-point"))
+
+	_, err := client.GrafanaIntegreatlyV1beta1().GrafanaContactPoints(existing.Namespace).Get(
+		context.Background(), existing.Name, metav1.GetOptions{},
+	)
+	assert.True(t, apierrs.IsNotFound(err))
+}
+
+func TestReadConfigRejectsUnknownStrategy(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "parameters.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("enable: true\nstrategy: mirrored\n"), 0o600))
+
+	_, err := ReadConfig(path)
+
+	assert.ErrorContains(t, err, "unknown strategy")
+}
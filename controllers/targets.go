@@ -0,0 +1,196 @@
+package controllers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"text/template"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// TargetNamespace maps v1alpha1 objects to namespaces of their converted objects.
+// Namespaces and name prefixes are Go templates of the v1alpha1 object, see targetTemplateData.
+type TargetNamespace struct {
+	// Namespace is the namespace of converted objects, empty keeps them in the namespace of their source
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	// Rules map matched v1alpha1 objects to namespaces, the first matching rule wins over Namespace
+	Rules []TargetNamespaceRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+	// NamePrefix is prepended to names of objects converted into another namespace than the one of their source
+	NamePrefix string `json:"namePrefix,omitempty" yaml:"namePrefix,omitempty"`
+	// AllowedNamespaces are namespaces the target namespace annotation of v1alpha1 objects may set besides
+	// the namespace of the object and the one the configuration maps it to
+	AllowedNamespaces []string `json:"allowedNamespaces,omitempty" yaml:"allowedNamespaces,omitempty"`
+}
+
+// TargetNamespaceRule maps v1alpha1 objects to a namespace, it matches objects which meet all its conditions
+type TargetNamespaceRule struct {
+	// Namespaces are namespaces of matched objects, empty matches objects of all namespaces
+	Namespaces []string `json:"namespaces,omitempty" yaml:"namespaces,omitempty"`
+	// Kinds are kinds of matched objects: dashboard, datasource, folder or notification, empty matches all kinds
+	Kinds []string `json:"kinds,omitempty" yaml:"kinds,omitempty"`
+	// Selector is the label selector of matched objects, empty matches all objects
+	Selector *metav1.LabelSelector `json:"selector,omitempty" yaml:"selector,omitempty"`
+	// Namespace is the namespace of objects converted from matched objects
+	Namespace string `json:"namespace" yaml:"namespace"`
+}
+
+// validate returns all problems of the target namespace configuration, with tenant isolation objects are placed
+// in the namespace of their Grafana instances, so only the name prefix may be set
+func (t TargetNamespace) validate(isolation IsolationMode) error {
+	var errs []error
+	if isolation == IsolationModeTenant && (t.Namespace != "" || len(t.Rules) > 0 || len(t.AllowedNamespaces) > 0) {
+		errs = append(errs, fmt.Errorf("targetNamespace: namespaces must not be set with %q isolation mode", IsolationModeTenant))
+	}
+	if _, err := compileTargetTemplate(t.Namespace); err != nil {
+		errs = append(errs, fmt.Errorf("targetNamespace.namespace: %w", err))
+	}
+	if _, err := compileTargetTemplate(t.NamePrefix); err != nil {
+		errs = append(errs, fmt.Errorf("targetNamespace.namePrefix: %w", err))
+	}
+	for _, namespace := range t.AllowedNamespaces {
+		for _, msg := range validation.IsDNS1123Label(namespace) {
+			errs = append(errs, fmt.Errorf("targetNamespace.allowedNamespaces: %q: %s", namespace, msg))
+		}
+	}
+	for i, rule := range t.Rules {
+		ruleErrs := validateSourceConditions(rule.Namespaces, rule.Kinds, rule.Selector)
+		if rule.Namespace == "" {
+			ruleErrs = append(ruleErrs, errors.New("namespace: must be set"))
+		} else if _, err := compileTargetTemplate(rule.Namespace); err != nil {
+			ruleErrs = append(ruleErrs, fmt.Errorf("namespace: %w", err))
+		}
+		for _, err := range ruleErrs {
+			errs = append(errs, fmt.Errorf("targetNamespace.rules[%d].%w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// targetTemplateData is the data of target namespace and name prefix templates, e.g. "{{ .Namespace }}-"
+type targetTemplateData struct {
+	// Namespace and Name are the ones of the v1alpha1 object
+	Namespace string
+	Name      string
+	// Kind is the kind of the object: dashboard, datasource, folder or notification
+	Kind        string
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// targetTemplates caches parsed templates by their text, templates are safe for concurrent execution
+var targetTemplates sync.Map
+
+// compileTargetTemplate parses a target namespace or name prefix template, missing keys of data fail its execution
+func compileTargetTemplate(text string) (*template.Template, error) {
+	if tmpl, ok := targetTemplates.Load(text); ok {
+		return tmpl.(*template.Template), nil
+	}
+	tmpl, err := template.New("target").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	targetTemplates.Store(text, tmpl)
+	return tmpl, nil
+}
+
+// renderTargetTemplate executes the target template with the v1alpha1 object of the kind
+func renderTargetTemplate(text, kind string, source metav1.Object) (string, error) {
+	tmpl, err := compileTargetTemplate(text)
+	if err != nil {
+		return "", err
+	}
+	data := targetTemplateData{
+		Namespace:   source.GetNamespace(),
+		Name:        source.GetName(),
+		Labels:      source.GetLabels(),
+		Annotations: source.GetAnnotations(),
+	}
+	for key, ruleKind := range ruleKinds {
+		if ruleKind == kind {
+			data.Kind = key
+		}
+	}
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// mappedNamespace returns the namespace of objects converted from the v1alpha1 object of the kind without tenant
+// isolation: the one of the target namespace annotation, of the first matching rule, the configured one
+// or the namespace of the object. The annotation may set only the namespace of the object, a namespace allowed
+// by the configuration or the one the configuration maps the object to.
+func mappedNamespace(conf ConverterConfig, kind string, source metav1.Object) (string, error) {
+	annotated := source.GetAnnotations()[targetNamespaceAnnotationKey]
+	if annotated != "" && allowedTargetNamespace(conf, source, annotated) {
+		return annotated, nil
+	}
+	text := conf.TargetNamespace.Namespace
+	for _, rule := range conf.TargetNamespace.Rules {
+		if matchesSource(rule.Namespaces, rule.Kinds, rule.Selector, kind, source) {
+			text = rule.Namespace
+			break
+		}
+	}
+	namespace := source.GetNamespace()
+	if text != "" {
+		var err error
+		if namespace, err = renderTargetTemplate(text, kind, source); err != nil {
+			return "", fmt.Errorf("%w: target namespace: %w", errUnplaceable, err)
+		}
+		if msgs := validation.IsDNS1123Label(namespace); len(msgs) > 0 {
+			return "", fmt.Errorf("%w: target namespace %q: %s", errUnplaceable, namespace, strings.Join(msgs, ", "))
+		}
+	}
+	if annotated != "" && annotated != namespace {
+		return "", fmt.Errorf("%w: annotation %s sets namespace %s, which is not in targetNamespace.allowedNamespaces",
+			errUnplaceable, targetNamespaceAnnotationKey, annotated)
+	}
+	return namespace, nil
+}
+
+// allowedTargetNamespace reports whether the target namespace annotation of the v1alpha1 object may set the namespace
+// regardless of the namespace the configuration maps the object to
+func allowedTargetNamespace(conf ConverterConfig, source metav1.Object, namespace string) bool {
+	return namespace == source.GetNamespace() || slices.Contains(conf.TargetNamespace.AllowedNamespaces, namespace)
+}
+
+// configuredTargetNamespaces returns the target namespaces named in the configuration, namespaces of templates
+// are known only when v1alpha1 objects are placed
+func configuredTargetNamespaces(conf ConverterConfig) []string {
+	namespaces := slices.Clone(conf.TargetNamespace.AllowedNamespaces)
+	texts := []string{conf.TargetNamespace.Namespace}
+	for _, rule := range conf.TargetNamespace.Rules {
+		texts = append(texts, rule.Namespace)
+	}
+	for _, text := range texts {
+		if text != "" && !strings.Contains(text, "{{") {
+			namespaces = append(namespaces, text)
+		}
+	}
+	if conf.IsolationMode == IsolationModeTenant {
+		for _, tenant := range conf.Tenants {
+			namespaces = append(namespaces, tenant.GrafanaNamespace)
+		}
+	}
+	return namespaces
+}
+
+// namePrefix returns the prefix of names of objects converted from the v1alpha1 object of the kind into the namespace,
+// objects converted into the namespace of their source keep their names
+func namePrefix(conf ConverterConfig, kind string, source metav1.Object, namespace string) (string, error) {
+	if conf.TargetNamespace.NamePrefix == "" || namespace == source.GetNamespace() {
+		return "", nil
+	}
+	prefix, err := renderTargetTemplate(conf.TargetNamespace.NamePrefix, kind, source)
+	if err != nil {
+		return "", fmt.Errorf("%w: name prefix: %w", errUnplaceable, err)
+	}
+	return prefix, nil
+}
@@ -0,0 +1,114 @@
+package controllers
+
+import (
+	"testing"
+
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
+	"github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTargetNamespaceValidation(t *testing.T) {
+	_, err := ParseConfig([]byte(`
+isolationMode: tenant
+targetNamespace:
+  namespace: monitoring
+  namePrefix: "{{ .Namespace"
+  allowedNamespaces: [Monitoring]
+  rules:
+  - kinds: [dashboards]
+`))
+	assert.ErrorContains(t, err, `targetNamespace: namespaces must not be set with "tenant" isolation mode`)
+	assert.ErrorContains(t, err, "targetNamespace.namePrefix: template: target:1: unclosed action")
+	assert.ErrorContains(t, err, `targetNamespace.rules[0].kinds: unknown kind "dashboards"`)
+	assert.ErrorContains(t, err, "targetNamespace.rules[0].namespace: must be set")
+	assert.ErrorContains(t, err, `targetNamespace.allowedNamespaces: "Monitoring": a lowercase RFC 1123 label`)
+}
+
+func TestTargetNamespaceMapping(t *testing.T) {
+	controller := &ConverterController{log: logr.Discard()}
+	conf := ConverterConfig{
+		DeletionPolicy: DeletionPolicies{Dashboard: DeletionPolicyOwnerReference},
+		TargetNamespace: TargetNamespace{
+			Namespace:         "monitoring",
+			NamePrefix:        "{{ .Namespace }}-",
+			AllowedNamespaces: []string{"product-b"},
+			Rules: []TargetNamespaceRule{
+				{Kinds: []string{"dashboard"}, Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"audience": "ops"}}, Namespace: "monitoring-ops"},
+				{Namespaces: []string{"team-a"}, Namespace: "grafana-{{ .Labels.team }}"},
+				{Namespaces: []string{"local"}, Namespace: "{{ .Namespace }}"},
+			},
+		},
+	}
+	controller.setConfig(conf)
+	dashboard := func(namespace string, labels, annotations map[string]string) *v1alpha1.GrafanaDashboard {
+		return &v1alpha1.GrafanaDashboard{ObjectMeta: metav1.ObjectMeta{
+			Name: "sample-dashboard", Namespace: namespace, UID: "uid", Labels: labels, Annotations: annotations,
+		}}
+	}
+	convert := func(source *v1alpha1.GrafanaDashboard) *v1beta1.GrafanaDashboard {
+		return controller.convertGrafanaDashboard(source, controller.place(v1alpha1.GrafanaDashboardKind, source))
+	}
+
+	converted := convert(dashboard("product-a", nil, nil))
+	assert.Equal(t, "monitoring", converted.Namespace)
+	assert.Equal(t, "product-a-sample-dashboard", converted.Name, "objects converted into another namespace get the name prefix")
+	assert.Empty(t, converted.OwnerReferences, "owner references can not point to another namespace")
+	assert.Equal(t, DeletionPolicyDelete, controller.convertedDeletionPolicy(conf.DeletionPolicy.Dashboard, dashboard("product-a", nil, nil), converted.Namespace))
+
+	converted = convert(dashboard("product-a", map[string]string{"audience": "ops"}, nil))
+	assert.Equal(t, "monitoring-ops", converted.Namespace, "the first matching rule wins")
+
+	converted = convert(dashboard("local", nil, nil))
+	assert.Equal(t, "local", converted.Namespace)
+	assert.Equal(t, "sample-dashboard", converted.Name, "objects converted into their namespace keep their names")
+	assert.NotEmpty(t, converted.OwnerReferences)
+
+	converted = convert(dashboard("product-a", nil, map[string]string{
+		targetNamespaceAnnotationKey: "product-b",
+		targetNameAnnotationKey:      "renamed-dashboard",
+	}))
+	assert.Equal(t, "product-b", converted.Namespace, "annotations take precedence over the configuration")
+	assert.Equal(t, "renamed-dashboard", converted.Name)
+
+	converted = convert(dashboard("team-a", map[string]string{"team": "a"}, nil))
+	assert.Equal(t, "grafana-a", converted.Namespace)
+
+	converted = convert(dashboard("team-a", map[string]string{"team": "a"}, map[string]string{targetNamespaceAnnotationKey: "grafana-a"}))
+	assert.Equal(t, "grafana-a", converted.Namespace, "annotations may set the namespace the configuration maps the object to")
+
+	converted = convert(dashboard("product-a", nil, map[string]string{targetNamespaceAnnotationKey: "product-a"}))
+	assert.Equal(t, "product-a", converted.Namespace, "annotations may keep objects in the namespace of their source")
+
+	p := controller.place(v1alpha1.GrafanaDashboardKind, dashboard("product-a", nil, map[string]string{targetNamespaceAnnotationKey: "kube-system"}))
+	assert.ErrorIs(t, p.err, errUnplaceable)
+	assert.ErrorContains(t, p.err, "annotation grafana-converter.qubership.org/target-namespace sets namespace kube-system, which is not in targetNamespace.allowedNamespaces")
+	assert.True(t, isPermanent(p.err))
+	assert.Equal(t, "product-a", p.namespace, "objects are never placed in namespaces which are not allowed")
+
+	err := controller.place(v1alpha1.GrafanaDashboardKind, dashboard("team-a", nil, nil)).err
+	assert.ErrorIs(t, err, errUnplaceable)
+	assert.ErrorContains(t, err, `map has no entry for key "team"`)
+	assert.True(t, isPermanent(err), "objects are converted again when the configuration changes")
+
+	err = controller.place(v1alpha1.GrafanaDashboardKind, dashboard("team-a", map[string]string{"team": "A"}, nil)).err
+	assert.ErrorContains(t, err, `target namespace "grafana-A"`)
+}
+
+func TestSeparateTargetCluster(t *testing.T) {
+	controller := &ConverterController{log: logr.Discard()}
+	conf := ConverterConfig{DeletionPolicy: DeletionPolicies{Dashboard: DeletionPolicyOwnerReference}}
+	controller.setConfig(conf)
+	controller.UseSeparateTargetCluster()
+	dashboard := &v1alpha1.GrafanaDashboard{ObjectMeta: metav1.ObjectMeta{
+		Name: "sample-dashboard", Namespace: "product-a", UID: "uid",
+		OwnerReferences: []metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: "owner", UID: "owner-uid"}},
+	}}
+
+	converted := controller.convertGrafanaDashboard(dashboard, controller.place(v1alpha1.GrafanaDashboardKind, dashboard))
+	assert.Equal(t, "product-a", converted.Namespace)
+	assert.Empty(t, converted.OwnerReferences, "owners do not exist in the target cluster")
+	assert.Equal(t, DeletionPolicyDelete, controller.convertedDeletionPolicy(conf.DeletionPolicy.Dashboard, dashboard, converted.Namespace))
+}
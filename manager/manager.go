@@ -2,12 +2,17 @@ package manager
 
 import (
 	"context"
+	"errors"
 	"flag"
+	"fmt"
+	"net/http"
 	"os"
 	"strings"
 
+	converterclientset "github.com/Netcracker/qubership-grafana-operator-converter/api/client/converter/clientset/versioned"
 	v1alpha1clientset "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1alpha1/clientset/versioned"
 	v1beta1clientset "github.com/Netcracker/qubership-grafana-operator-converter/api/client/v1beta1/clientset/versioned"
+	converterv1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/converter/v1alpha1"
 	grafanav1alpha1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1alpha1"
 	grafanav1beta1 "github.com/Netcracker/qubership-grafana-operator-converter/api/operator/v1beta1"
 	converterController "github.com/Netcracker/qubership-grafana-operator-converter/controllers"
@@ -17,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -54,8 +60,12 @@ var (
 	enableLeaderElection = flag.Bool("leader-elect", false, "Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
 	kubeconfig           *string
 
+	targetKubeconfig = flag.String("target-kubeconfig", "", "Path to kubeconfig file of the cluster converted grafana.integreatly.org/v1beta1 objects are written to (optional, if not set, the cluster of --kubeconfig is used)")
+	targetContext    = flag.String("target-context", "", "Context of the target cluster in --target-kubeconfig, or in --kubeconfig if --target-kubeconfig is not set (optional, the current context is used by default)")
+
 	resyncPeriod        = flag.Duration("controller.resyncPeriod", 0, "Configures resync period for grafana CRD converter. Disabled by default")
 	converterConfigPath = flag.String("controller.config", "/opt/grafana-converter/parameters.yaml", "Grafana CRD converter configure.")
+	validateConfig      = flag.Bool("validate-config", false, "Validate Grafana CRD converter configuration, print its problems and exit.")
 )
 
 func init() {
@@ -65,6 +75,9 @@ func init() {
 	utilruntime.Must(grafanav1alpha1.AddToScheme(scheme))
 	utilruntime.Must(grafanav1beta1.AddToScheme(scheme))
 
+	// converter configuration API
+	utilruntime.Must(converterv1alpha1.AddToScheme(scheme))
+
 	// openshift route API
 	utilruntime.Must(routev1.Install(scheme))
 
@@ -84,6 +97,10 @@ func RunManager(ctx context.Context) (err error) {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	if *validateConfig {
+		return validateConverterConfig(*converterConfigPath)
+	}
+
 	setupLog.Info("Starting the Grafana Converter")
 
 	// Get Kubernetes config
@@ -147,36 +164,78 @@ func RunManager(ctx context.Context) (err error) {
 		return err
 	}
 
-	v1alpha1Client, err := v1alpha1clientset.NewForConfig(cfg)
+	// converter clients report latency of their API requests
+	converterCfg := rest.CopyConfig(cfg)
+	converterCfg.Wrap(converterController.InstrumentRoundTripper)
+
+	v1alpha1Client, err := v1alpha1clientset.NewForConfig(converterCfg)
 	if err != nil {
 		setupLog.Error(err, "Error building v1alpha1 clientset")
 		return err
 	}
 
-	v1beta1Client, err := v1beta1clientset.NewForConfig(cfg)
+	// converted objects may be written to another cluster, informers of sources and leader election stay on this one
+	targetCfg, separateTarget, err := buildTargetConfig(cfg)
+	if err != nil {
+		setupLog.Error(err, "unable to get kubernetes config of the target cluster")
+		return err
+	}
+	targetCfg.Wrap(converterController.InstrumentRoundTripper)
+
+	v1beta1Client, err := v1beta1clientset.NewForConfig(targetCfg)
 	if err != nil {
 		setupLog.Error(err, "Error building v1beta1 clientset")
 		return err
 	}
 
-	converterController, err := converterController.NewGrafanaConverterController(ctx, *converterConfigPath, v1alpha1Client, v1beta1Client, *resyncPeriod, ctrl.Log.WithName("ConverterController"))
+	configurationClient, err := converterclientset.NewForConfig(converterCfg)
+	if err != nil {
+		setupLog.Error(err, "Error building converter configuration clientset")
+		return err
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(converterCfg)
+	if err != nil {
+		setupLog.Error(err, "Error building kubernetes clientset")
+		return err
+	}
+
+	converterController, err := converterController.NewGrafanaConverterController(ctx, *converterConfigPath, v1alpha1Client, v1beta1Client, configurationClient, kubeClient, mgr.GetEventRecorder("grafana-operator-converter"), *resyncPeriod, ctrl.Log.WithName("ConverterController"))
 	if err != nil {
 		setupLog.Error(err, "cannot setup grafana CRD converter")
-	} else if converterController.ConverterConf.Enable {
-		if err = mgr.Add(converterController); err != nil {
-			setupLog.Error(err, "cannot add runnable")
-			return err
-		}
+		return err
+	}
+	if separateTarget {
+		setupLog.Info("converted objects are written to the target cluster", "host", targetCfg.Host)
+		converterController.UseSeparateTargetCluster()
+	}
+	// a disabled converter only watches its configuration file until it is enabled
+	if err = mgr.Add(converterController); err != nil {
+		setupLog.Error(err, "cannot add runnable")
+		return err
 	}
+	readyzChecks, healthzChecks := converterController.ReadyzChecks(), converterController.HealthzChecks()
 
 	if err = mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		return err
 	}
+	for name, check := range healthzChecks {
+		if err = mgr.AddHealthzCheck(name, check); err != nil {
+			setupLog.Error(err, "unable to set up health check", "check", name)
+			return err
+		}
+	}
 	if err = mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up ready check")
 		return err
 	}
+	for name, check := range readyzChecks {
+		if err = mgr.AddReadyzCheck(name, whenElected(mgr, check)); err != nil {
+			setupLog.Error(err, "unable to set up ready check", "check", name)
+			return err
+		}
+	}
 
 	setupLog.Info("starting manager")
 	if err = mgr.Start(ctx); err != nil {
@@ -187,6 +246,72 @@ func RunManager(ctx context.Context) (err error) {
 	return err
 }
 
+// buildTargetConfig returns the config of the cluster converted objects are written to and whether it is another
+// cluster than the one of the source config, which is returned without --target-kubeconfig and --target-context
+func buildTargetConfig(source *rest.Config) (*rest.Config, bool, error) {
+	if *targetKubeconfig == "" && *targetContext == "" {
+		return rest.CopyConfig(source), false, nil
+	}
+	path := *targetKubeconfig
+	if path == "" {
+		path = *kubeconfig
+	}
+	if path == "" {
+		return nil, false, errors.New("--target-context requires --target-kubeconfig or --kubeconfig")
+	}
+	cfg, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: path},
+		&clientcmd.ConfigOverrides{CurrentContext: *targetContext},
+	).ClientConfig()
+	if err != nil {
+		return nil, false, fmt.Errorf("cannot load target kubeconfig %s: %w", path, err)
+	}
+	return cfg, true, nil
+}
+
+// whenElected runs the check only on the leader, the converter does not start on other replicas
+// and they are ready to take over as they are
+func whenElected(mgr manager.Manager, check healthz.Checker) healthz.Checker {
+	return func(req *http.Request) error {
+		select {
+		case <-mgr.Elected():
+			return check(req)
+		default:
+			return nil
+		}
+	}
+}
+
+// validateConverterConfig prints all problems of the converter configuration file,
+// the returned error makes the process exit with a non-zero code
+func validateConverterConfig(path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("cannot read grafana CRD converter configuration: %w", err)
+	}
+	if _, err := converterController.ReadConfig(path); err != nil {
+		fmt.Fprintf(os.Stderr, "grafana CRD converter configuration %s is invalid:\n", path)
+		for _, problem := range configProblems(err) {
+			fmt.Fprintf(os.Stderr, "  - %v\n", problem)
+		}
+		return fmt.Errorf("grafana CRD converter configuration %s is invalid", path)
+	}
+	fmt.Printf("grafana CRD converter configuration %s is valid\n", path)
+	return nil
+}
+
+// configProblems flattens joined configuration errors to a list of problems
+func configProblems(err error) []error {
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []error{err}
+	}
+	var problems []error
+	for _, err := range joined.Unwrap() {
+		problems = append(problems, configProblems(err)...)
+	}
+	return problems
+}
+
 func getNamespaceConfig(namespaces string) map[string]cache.Config {
 	defaultNamespaces := map[string]cache.Config{}
 	for _, v := range strings.Split(namespaces, ",") {